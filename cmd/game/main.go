@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"os"
 	"os/signal"
@@ -11,8 +12,14 @@ import (
 )
 
 func main() {
+	// Configuración desde la línea de comandos
+	cfg := core.DefaultConfig()
+	flag.BoolVar(&cfg.EnableEventLog, "event-log", cfg.EnableEventLog, "guardar los eventos de combate en JSONL (un archivo por pelea)")
+	flag.StringVar(&cfg.EventLogDir, "event-log-dir", cfg.EventLogDir, "carpeta de los archivos del event log")
+	flag.Parse()
+
 	// Crear el juego
-	game := core.NewGame()
+	game := core.NewGameWithConfig(cfg)
 
 	// Setup para limpiar recursos al cerrar
 	setupCleanup(game)
@@ -3,101 +3,405 @@ package ai
 
 import (
 	"math"
+	"math/rand"
 
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
 	"github.com/MarcosBrindis/boss-arena-go/internal/projectiles"
 	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
 )
 
-// DodgeSystem maneja la lógica de esquiva del boss
+// ============================================================================
+// DECISIÓN
+// ============================================================================
+
+// DodgeAction es la reacción elegida ante un proyectil
+type DodgeAction int
+
+const (
+	DodgeNone DodgeAction = iota // Nada que esquivar (o todavía no reaccionó)
+	DodgeStep                    // Paso lateral
+	DodgeJump                    // Salto por encima
+	DodgeTank                    // Aguanta el golpe
+)
+
+// String retorna el nombre de la acción
+func (da DodgeAction) String() string {
+	switch da {
+	case DodgeNone:
+		return "None"
+	case DodgeStep:
+		return "Step"
+	case DodgeJump:
+		return "Jump"
+	case DodgeTank:
+		return "Tank"
+	default:
+		return "Unknown"
+	}
+}
+
+// DodgeDecision es el resultado de evaluar las amenazas de un frame
+type DodgeDecision struct {
+	Action       DodgeAction
+	Direction    float64 // -1 izquierda, 1 derecha (solo DodgeStep)
+	StepSpeed    float64 // Velocidad del paso (solo DodgeStep)
+	StepFrames   int     // Duración del paso (solo DodgeStep)
+	TimeToImpact float64 // Frames hasta el impacto de la amenaza
+	Threat       projectiles.ProjectileType
+}
+
+// DodgeContext describe a quien esquiva en este frame
+type DodgeContext struct {
+	Dodger   combat.ActorID
+	Hurtbox  utils.Rectangle
+	OnGround bool
+	CanAct   bool // false si está comprometido (atacando, aturdido...): solo puede aguantar
+
+	// Límites del centro en X (no esquivar contra la pared)
+	MinX, MaxX float64
+
+	// Física del salto (para saber si llega a subir a tiempo)
+	JumpVelocity float64
+	Gravity      float64
+}
+
+// ============================================================================
+// CONFIGURACIÓN
+// ============================================================================
+
+// DodgeConfig contiene la configuración de la esquiva
+type DodgeConfig struct {
+	LookAhead      float64 // Frames hacia adelante que se predicen las trayectorias
+	ReactionFrames []int   // Retraso antes de reaccionar (índice = dificultad - 1)
+
+	// Paso lateral
+	StepSpeed  float64
+	StepFrames int // Frames que dura el paso
+
+	// Límite de esquivas para que siga siendo vencible
+	MaxDodges   int // Esquivas permitidas...
+	DodgeWindow int // ...en esta ventana de frames
+
+	// Aguantar: los golpes flojos a veces se aguantan para no perder el ritmo
+	TankDamage int     // Daño a partir del cual nunca aguanta por elección
+	TankChance float64 // Probabilidad de aguantar un golpe flojo
+}
+
+// DefaultDodgeConfig retorna la configuración por defecto
+func DefaultDodgeConfig() DodgeConfig {
+	return DodgeConfig{
+		LookAhead:      45,
+		ReactionFrames: []int{22, 15, 9}, // Fácil, normal, difícil
+		StepSpeed:      8.0,
+		StepFrames:     12,
+		MaxDodges:      3,
+		DodgeWindow:    300, // 5 segundos
+		TankDamage:     20,
+		TankChance:     0.35,
+	}
+}
+
+// ============================================================================
+// SISTEMA
+// ============================================================================
+
+// DodgeSystem decide cómo reaccionar a los proyectiles que van a impactar.
+// Predice la trayectoria de cada proyectil (tiempo hasta el punto de máximo
+// acercamiento, o simulando la persecución de los homing), espera un tiempo
+// de reacción y elige entre paso lateral, salto o aguantar el golpe.
+// Se usa desde el hilo del juego (no es thread-safe).
 type DodgeSystem struct {
-	// Configuración
-	detectionRadius float64
-	reactionTime    int // Frames antes de reaccionar
-	dodgeSpeed      float64
+	config     DodgeConfig
+	difficulty int
+	rng        *rand.Rand
 
-	// Estado
-	isDetectingThreat bool
-	threatPosition    utils.Vector2
-	dodgeTimer        int
+	frame int
+
+	// Amenazas vistas: AttackID -> frame en que se detectó
+	noticed map[uint64]int
+	// Amenazas ya resueltas (esquivadas o aguantadas)
+	handled map[uint64]bool
+	// Amenazas vistas en el frame actual (se vacía cada frame)
+	seen map[uint64]bool
+	// Frames de las últimas esquivas (presupuesto)
+	recentDodges []int
+
+	last DodgeDecision
 }
 
 // NewDodgeSystem crea un nuevo sistema de esquiva
-func NewDodgeSystem() *DodgeSystem {
+func NewDodgeSystem(cfg DodgeConfig, rng *rand.Rand) *DodgeSystem {
 	return &DodgeSystem{
-		detectionRadius: 200.0,
-		reactionTime:    15, // 0.25 segundos @ 60 FPS
-		dodgeSpeed:      5.0,
+		config:       cfg,
+		difficulty:   2,
+		rng:          rng,
+		noticed:      make(map[uint64]int),
+		handled:      make(map[uint64]bool),
+		seen:         make(map[uint64]bool),
+		recentDodges: make([]int, 0, cfg.MaxDodges),
+	}
+}
+
+// SetDifficulty ajusta el tiempo de reacción (1 = fácil, 2 = normal, 3 = difícil)
+func (ds *DodgeSystem) SetDifficulty(level int) {
+	ds.difficulty = level
+}
+
+// reactionFrames retorna el retraso de reacción de la dificultad actual
+func (ds *DodgeSystem) reactionFrames() int {
+	frames := ds.config.ReactionFrames
+	if len(frames) == 0 {
+		return 0
+	}
+	index := ds.difficulty - 1
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(frames) {
+		index = len(frames) - 1
 	}
+	return frames[index]
 }
 
-// ShouldDodge verifica si el boss debe esquivar un proyectil
-func (ds *DodgeSystem) ShouldDodge(
-	bossPosition utils.Vector2,
-	projectileList []*projectiles.Projectile,
-) (bool, utils.Vector2) {
-	// Buscar proyectiles cercanos del jugador
+// Update evalúa las amenazas del frame (llamar una vez por frame)
+func (ds *DodgeSystem) Update(ctx *DodgeContext, projectileList []*projectiles.Projectile) DodgeDecision {
+	ds.frame++
+	ds.pruneDodges()
+
+	// Amenaza más urgente que ya superó el tiempo de reacción
+	var threat *projectiles.Projectile
+	threatTime := math.Inf(1)
+	clear(ds.seen)
+
 	for _, proj := range projectileList {
-		if proj.Owner != "player" || !proj.IsActive {
+		if !proj.IsActive || !combat.CanHarm(proj.Owner, ctx.Dodger) {
 			continue
 		}
 
-		// Calcular distancia al proyectil
-		distance := bossPosition.Distance(proj.Position)
+		impact, ok := ds.timeToImpact(ctx.Hurtbox, utils.Zero(), proj)
+		if !ok {
+			continue
+		}
 
-		// Si está dentro del radio de detección
-		if distance <= ds.detectionRadius {
-			// Predecir si el proyectil va a impactar
-			willHit := ds.predictImpact(bossPosition, proj)
+		ds.seen[proj.AttackID] = true
+		first, known := ds.noticed[proj.AttackID]
+		if !known {
+			first = ds.frame
+			ds.noticed[proj.AttackID] = first
+		}
 
-			if willHit {
-				// Calcular dirección de esquiva (perpendicular al proyectil)
-				dodgeDirection := ds.calculateDodgeDirection(bossPosition, proj)
-				return true, dodgeDirection
-			}
+		if ds.handled[proj.AttackID] || ds.frame-first < ds.reactionFrames() {
+			continue
+		}
+		if impact < threatTime {
+			threat, threatTime = proj, impact
 		}
 	}
 
-	return false, utils.Zero()
+	// Olvidar amenazas que ya no existen o ya no van a impactar
+	for id := range ds.noticed {
+		if !ds.seen[id] {
+			delete(ds.noticed, id)
+			delete(ds.handled, id)
+		}
+	}
+
+	if threat == nil {
+		ds.last = DodgeDecision{}
+		return ds.last
+	}
+
+	// Comprometido (atacando, en el aire...): aguanta por ahora, pero la
+	// amenaza sigue pendiente por si se libera antes del impacto
+	if !ctx.CanAct || !ctx.OnGround {
+		ds.last = DodgeDecision{Action: DodgeTank, TimeToImpact: threatTime, Threat: threat.Type}
+		return ds.last
+	}
+
+	decision := ds.choose(ctx, threat, threatTime)
+	ds.handled[threat.AttackID] = true
+	if decision.Action == DodgeStep || decision.Action == DodgeJump {
+		ds.recentDodges = append(ds.recentDodges, ds.frame)
+	}
+
+	ds.last = decision
+	return decision
 }
 
-// predictImpact predice si un proyectil va a impactar al boss
-func (ds *DodgeSystem) predictImpact(bossPosition utils.Vector2, proj *projectiles.Projectile) bool {
-	// Vector del proyectil al boss
-	toBoss := bossPosition.Sub(proj.Position)
+// choose elige la reacción ante una amenaza
+func (ds *DodgeSystem) choose(ctx *DodgeContext, proj *projectiles.Projectile, impact float64) DodgeDecision {
+	decision := DodgeDecision{Action: DodgeTank, TimeToImpact: impact, Threat: proj.Type}
 
-	// Normalizar velocidad del proyectil
-	projDirection := proj.Velocity.Normalize()
+	// Sin presupuesto: aguanta
+	if ds.DodgesLeft() == 0 {
+		return decision
+	}
+
+	// Los golpes flojos a veces se aguantan
+	if proj.Damage < ds.config.TankDamage && ds.rng != nil && ds.rng.Float64() < ds.config.TankChance {
+		return decision
+	}
 
-	// Producto punto para ver si el proyectil apunta hacia el boss
-	dot := toBoss.Dot(projDirection)
+	// Paso lateral: alejarse del punto de impacto, hacia el lado con espacio
+	center := ctx.Hurtbox.Center()
+	directions := []float64{1, -1}
+	if proj.Position.X > center.X {
+		directions = []float64{-1, 1}
+	}
+	stepFrames := utils.Min(impact, float64(ds.config.StepFrames))
+	for _, direction := range directions {
+		dx := direction * ds.config.StepSpeed * stepFrames
+		if center.X+dx < ctx.MinX || center.X+dx > ctx.MaxX {
+			continue
+		}
+		if _, hits := ds.timeToImpact(ctx.Hurtbox, utils.NewVector2(dx, 0), proj); !hits {
+			decision.Action = DodgeStep
+			decision.Direction = direction
+			decision.StepSpeed = ds.config.StepSpeed
+			decision.StepFrames = ds.config.StepFrames
+			return decision
+		}
+	}
 
-	// Si el proyectil va hacia el boss y está cerca
-	return dot > 0 && toBoss.Length() < ds.detectionRadius
+	// Salto: ¿sube lo suficiente antes del impacto?
+	if rise := jumpRise(ctx.JumpVelocity, ctx.Gravity, impact); rise > 0 {
+		if _, hits := ds.timeToImpact(ctx.Hurtbox, utils.NewVector2(0, -rise), proj); !hits {
+			decision.Action = DodgeJump
+			return decision
+		}
+	}
+
+	// Ninguna esquiva sirve
+	return decision
 }
 
-// calculateDodgeDirection calcula la dirección de esquiva
-func (ds *DodgeSystem) calculateDodgeDirection(bossPosition utils.Vector2, proj *projectiles.Projectile) utils.Vector2 {
-	// Vector perpendicular a la velocidad del proyectil
-	perpendicular := utils.Vector2{
-		X: -proj.Velocity.Y,
-		Y: proj.Velocity.X,
+// jumpRise retorna la altura alcanzada tras t frames de salto
+func jumpRise(velocity, gravity, t float64) float64 {
+	if velocity <= 0 || gravity <= 0 {
+		return 0
 	}
+	// No pasa del punto más alto
+	t = utils.Min(t, velocity/gravity)
+	return velocity*t - gravity*t*t/2
+}
 
-	// Normalizar y aplicar velocidad de esquiva
-	dodgeDir := perpendicular.Normalize().Mul(ds.dodgeSpeed)
+// DodgesLeft retorna cuántas esquivas quedan en la ventana actual
+func (ds *DodgeSystem) DodgesLeft() int {
+	left := ds.config.MaxDodges - len(ds.recentDodges)
+	if left < 0 {
+		return 0
+	}
+	return left
+}
 
-	// Verificar que no se salga de los límites
-	futurePos := bossPosition.Add(dodgeDir.Mul(10)) // Predecir 10 frames adelante
+// pruneDodges olvida las esquivas que salieron de la ventana
+func (ds *DodgeSystem) pruneDodges() {
+	kept := ds.recentDodges[:0]
+	for _, frame := range ds.recentDodges {
+		if ds.frame-frame < ds.config.DodgeWindow {
+			kept = append(kept, frame)
+		}
+	}
+	ds.recentDodges = kept
+}
 
-	// Si se sale por la derecha, esquivar a la izquierda
-	if futurePos.X > 1200 {
-		dodgeDir.X = -math.Abs(dodgeDir.X)
+// LastDecision retorna la última decisión (para el overlay de debug)
+func (ds *DodgeSystem) LastDecision() DodgeDecision {
+	return ds.last
+}
+
+// Reset olvida amenazas y esquivas (al reiniciar la pelea)
+func (ds *DodgeSystem) Reset() {
+	ds.noticed = make(map[uint64]int)
+	ds.handled = make(map[uint64]bool)
+	clear(ds.seen)
+	ds.recentDodges = ds.recentDodges[:0]
+	ds.last = DodgeDecision{}
+}
+
+// ============================================================================
+// PREDICCIÓN DE TRAYECTORIAS
+// ============================================================================
+
+// timeToImpact retorna en cuántos frames el proyectil toca el hurtbox
+// desplazado por offset (false si no lo toca dentro de LookAhead)
+func (ds *DodgeSystem) timeToImpact(hurtbox utils.Rectangle, offset utils.Vector2, proj *projectiles.Projectile) (float64, bool) {
+	// El hurtbox crece con el tamaño del proyectil (los cargados son más grandes)
+	expanded := utils.NewRectangle(
+		hurtbox.X+offset.X-proj.Size.X/2,
+		hurtbox.Y+offset.Y-proj.Size.Y/2,
+		hurtbox.Width+proj.Size.X,
+		hurtbox.Height+proj.Size.Y,
+	)
+
+	if proj.IsHoming && proj.Target != nil {
+		// El homing persigue al que esquiva: apunta a la posición ya desplazada
+		return ds.simulateHoming(expanded, proj.Target.Add(offset), proj)
+	}
+	return ds.straightImpact(expanded, proj.Position, proj.Velocity)
+}
+
+// straightImpact predice un proyectil en línea recta. El tiempo de máximo
+// acercamiento al centro descarta los que se alejan; el cruce con el área
+// (método de slabs) da el momento de entrada.
+func (ds *DodgeSystem) straightImpact(area utils.Rectangle, position, velocity utils.Vector2) (float64, bool) {
+	speedSq := velocity.Dot(velocity)
+	if speedSq == 0 {
+		return 0, false
 	}
 
-	// Si se sale por la izquierda, esquivar a la derecha
-	if futurePos.X < 80 {
-		dodgeDir.X = math.Abs(dodgeDir.X)
+	closest := area.Center().Sub(position).Dot(velocity) / speedSq
+	if closest < 0 {
+		return 0, false // Ya pasó de largo
+	}
+
+	enter, exit, crosses := crossing(area, position, velocity)
+	if !crosses || exit < 0 || enter > ds.config.LookAhead {
+		return 0, false
+	}
+	return utils.Max(enter, 0), true
+}
+
+// crossing retorna cuándo entra y sale del área un punto que se mueve en línea recta
+func crossing(area utils.Rectangle, position, velocity utils.Vector2) (enter, exit float64, crosses bool) {
+	enter, exit = math.Inf(-1), math.Inf(1)
+	axes := [][4]float64{
+		{position.X, velocity.X, area.Left(), area.Right()},
+		{position.Y, velocity.Y, area.Top(), area.Bottom()},
 	}
+	for _, axis := range axes {
+		pos, vel, low, high := axis[0], axis[1], axis[2], axis[3]
+		if vel == 0 {
+			// Quieto en este eje: o siempre está dentro o nunca
+			if pos < low || pos > high {
+				return 0, 0, false
+			}
+			continue
+		}
+		t1, t2 := (low-pos)/vel, (high-pos)/vel
+		enter = utils.Max(enter, utils.Min(t1, t2))
+		exit = utils.Min(exit, utils.Max(t1, t2))
+	}
+	return enter, exit, enter <= exit
+}
+
+// simulateHoming avanza una copia del proyectil con la misma ley de persecución
+// hacia target (donde va a estar el objetivo) y retorna cuándo toca el área
+func (ds *DodgeSystem) simulateHoming(area utils.Rectangle, target utils.Vector2, proj *projectiles.Projectile) (float64, bool) {
+	position, velocity := proj.Position, proj.Velocity
 
-	return dodgeDir
+	for frame := 0; frame <= int(ds.config.LookAhead); frame++ {
+		if area.Contains(position) {
+			return float64(frame), true
+		}
+
+		if toTarget := target.Sub(position); toTarget.Length() > 0 {
+			velocity = velocity.Add(toTarget.Normalize().Mul(proj.HomingForce))
+			if velocity.Length() > proj.Speed {
+				velocity = velocity.Normalize().Mul(proj.Speed)
+			}
+		}
+		position = position.Add(velocity)
+	}
+	return 0, false
 }
@@ -0,0 +1,317 @@
+package ai
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
+	"github.com/MarcosBrindis/boss-arena-go/internal/projectiles"
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+)
+
+// dodgeHurtbox es el hurtbox de prueba: 100..140 en ambos ejes (centro 120, 120).
+// Con proyectiles de 10x10 el área de impacto es 95..145.
+var dodgeHurtbox = utils.NewRectangle(100, 100, 40, 40)
+
+// testProjectile crea un proyectil del jugador de 10x10
+func testProjectile(position, velocity utils.Vector2) *projectiles.Projectile {
+	return &projectiles.Projectile{
+		AttackID: combat.NewAttackID(),
+		Position: position,
+		Velocity: velocity,
+		Size:     utils.NewVector2(10, 10),
+		Speed:    velocity.Length(),
+		Damage:   30,
+		IsActive: true,
+		Owner:    combat.PlayerActor,
+	}
+}
+
+// homing convierte el proyectil en uno que persigue a target
+func homing(proj *projectiles.Projectile, target utils.Vector2, force float64) *projectiles.Projectile {
+	proj.IsHoming = true
+	proj.Target = &target
+	proj.HomingForce = force
+	return proj
+}
+
+func TestJumpRise(t *testing.T) {
+	tests := []struct {
+		name                 string
+		velocity, gravity, t float64
+		want                 float64
+	}{
+		{"subiendo", 10, 0.5, 4, 36},
+		{"no pasa del punto más alto", 10, 0.5, 100, 100},
+		{"justo en el punto más alto", 10, 0.5, 20, 100},
+		{"sin impulso", 0, 0.5, 10, 0},
+		{"sin gravedad", 10, 0, 10, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jumpRise(tt.velocity, tt.gravity, tt.t); !almostEqual(got, tt.want) {
+				t.Errorf("jumpRise = %v, se esperaba %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrossing(t *testing.T) {
+	area := utils.NewRectangle(100, 100, 40, 40)
+
+	tests := []struct {
+		name        string
+		position    utils.Vector2
+		velocity    utils.Vector2
+		enter, exit float64
+		crosses     bool
+	}{
+		{"horizontal", utils.NewVector2(0, 120), utils.NewVector2(5, 0), 20, 28, true},
+		{"diagonal", utils.NewVector2(0, 0), utils.NewVector2(10, 10), 10, 14, true},
+		{"paralelo por fuera", utils.NewVector2(0, 50), utils.NewVector2(5, 0), 0, 0, false},
+		{"diagonal que no llega a cruzar", utils.NewVector2(0, 200), utils.NewVector2(10, -1), 0, 0, false},
+		{"alejándose (tiempos negativos)", utils.NewVector2(200, 120), utils.NewVector2(5, 0), -20, -12, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enter, exit, crosses := crossing(area, tt.position, tt.velocity)
+			if crosses != tt.crosses {
+				t.Fatalf("crosses = %v, se esperaba %v", crosses, tt.crosses)
+			}
+			if crosses && (!almostEqual(enter, tt.enter) || !almostEqual(exit, tt.exit)) {
+				t.Errorf("crossing = (%v, %v), se esperaba (%v, %v)", enter, exit, tt.enter, tt.exit)
+			}
+		})
+	}
+}
+
+func TestTimeToImpact(t *testing.T) {
+	// El centro del hurtbox: a donde apuntan los homing
+	center := dodgeHurtbox.Center()
+
+	tests := []struct {
+		name   string
+		proj   *projectiles.Projectile
+		offset utils.Vector2
+		time   float64
+		hits   bool
+	}{
+		{
+			name: "recto hacia el hurtbox",
+			proj: testProjectile(utils.NewVector2(0, 120), utils.NewVector2(5, 0)),
+			time: 19, // Entra al área expandida en x = 95
+			hits: true,
+		},
+		{
+			name: "el tamaño del proyectil agranda el área",
+			proj: testProjectile(utils.NewVector2(0, 143), utils.NewVector2(5, 0)),
+			time: 19,
+			hits: true,
+		},
+		{
+			name: "ya dentro",
+			proj: testProjectile(utils.NewVector2(110, 120), utils.NewVector2(5, 0)),
+			time: 0,
+			hits: true,
+		},
+		{
+			name: "ya pasó de largo",
+			proj: testProjectile(utils.NewVector2(200, 120), utils.NewVector2(5, 0)),
+		},
+		{
+			name: "más allá de LookAhead",
+			proj: testProjectile(utils.NewVector2(-200, 120), utils.NewVector2(5, 0)),
+		},
+		{
+			name: "pasa por encima",
+			proj: testProjectile(utils.NewVector2(0, 50), utils.NewVector2(5, 0)),
+		},
+		{
+			name: "quieto",
+			proj: testProjectile(utils.NewVector2(0, 120), utils.Zero()),
+		},
+		{
+			name:   "desplazado: el paso atrasa el impacto",
+			proj:   testProjectile(utils.NewVector2(0, 120), utils.NewVector2(5, 0)),
+			offset: utils.NewVector2(60, 0),
+			time:   31,
+			hits:   true,
+		},
+		{
+			name:   "desplazado: el salto lo deja pasar por debajo",
+			proj:   testProjectile(utils.NewVector2(0, 120), utils.NewVector2(5, 0)),
+			offset: utils.NewVector2(0, -100),
+		},
+		{
+			name: "homing en línea recta",
+			proj: homing(testProjectile(utils.NewVector2(0, 120), utils.NewVector2(5, 0)), center, 0.5),
+			time: 19,
+			hits: true,
+		},
+		{
+			name: "homing fuera de alcance",
+			proj: homing(testProjectile(utils.NewVector2(-400, 120), utils.NewVector2(5, 0)), center, 0.5),
+		},
+		{
+			// El homing persigue al que esquiva: un paso corto no alcanza
+			name:   "homing sigue al objetivo desplazado",
+			proj:   homing(testProjectile(utils.NewVector2(0, 120), utils.NewVector2(5, 0)), center, 0.5),
+			offset: utils.NewVector2(0, -40),
+			hits:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := NewDodgeSystem(DefaultDodgeConfig(), nil)
+			got, hits := ds.timeToImpact(dodgeHurtbox, tt.offset, tt.proj)
+			if hits != tt.hits {
+				t.Fatalf("hits = %v (t = %v), se esperaba %v", hits, got, tt.hits)
+			}
+			if hits && tt.time > 0 && !almostEqual(got, tt.time) {
+				t.Errorf("timeToImpact = %v, se esperaba %v", got, tt.time)
+			}
+			if hits && got > DefaultDodgeConfig().LookAhead {
+				t.Errorf("timeToImpact = %v, más allá de LookAhead", got)
+			}
+		})
+	}
+}
+
+func TestHomingFollowsDodger(t *testing.T) {
+	ds := NewDodgeSystem(DefaultDodgeConfig(), nil)
+	proj := homing(testProjectile(utils.NewVector2(0, 120), utils.NewVector2(5, 0)), dodgeHurtbox.Center(), 0.5)
+	offset := utils.NewVector2(0, -40)
+
+	// Contra un objetivo quieto el disparo seguiría recto y el paso serviría
+	expanded := utils.NewRectangle(95, 55, 50, 50)
+	if _, hits := ds.simulateHoming(expanded, *proj.Target, proj); hits {
+		t.Fatal("apuntando a la posición vieja no debería alcanzar el área desplazada")
+	}
+	if _, hits := ds.timeToImpact(dodgeHurtbox, offset, proj); !hits {
+		t.Error("el homing debería perseguir la posición esquivada")
+	}
+}
+
+func TestDodgeChoose(t *testing.T) {
+	baseCtx := DodgeContext{
+		Dodger:       combat.BossActor,
+		Hurtbox:      dodgeHurtbox,
+		OnGround:     true,
+		CanAct:       true,
+		MinX:         0,
+		MaxX:         math.Inf(1),
+		JumpVelocity: 13,
+		Gravity:      0.8,
+	}
+	falling := func() *projectiles.Projectile {
+		return testProjectile(utils.NewVector2(110, 0), utils.NewVector2(0, 5))
+	}
+
+	tests := []struct {
+		name      string
+		proj      *projectiles.Projectile
+		ctx       func(*DodgeContext)
+		spent     int // Esquivas ya gastadas
+		action    DodgeAction
+		direction float64
+	}{
+		{
+			name:      "cae desde arriba: paso lejos del impacto",
+			proj:      falling(),
+			action:    DodgeStep,
+			direction: 1,
+		},
+		{
+			name:      "viene desde la derecha: paso a la izquierda",
+			proj:      testProjectile(utils.NewVector2(300, 0), utils.NewVector2(-3.6, 2.4)),
+			action:    DodgeStep,
+			direction: -1,
+		},
+		{
+			name:      "pared a la derecha: paso al otro lado",
+			proj:      falling(),
+			ctx:       func(ctx *DodgeContext) { ctx.MaxX = 150 },
+			action:    DodgeStep,
+			direction: -1,
+		},
+		{
+			name:   "horizontal a ras de suelo: salta",
+			proj:   testProjectile(utils.NewVector2(0, 120), utils.NewVector2(5, 0)),
+			action: DodgeJump,
+		},
+		{
+			name:   "horizontal sin salto suficiente: aguanta",
+			proj:   testProjectile(utils.NewVector2(0, 120), utils.NewVector2(5, 0)),
+			ctx:    func(ctx *DodgeContext) { ctx.JumpVelocity = 4 },
+			action: DodgeTank,
+		},
+		{
+			name:   "sin presupuesto: aguanta",
+			proj:   falling(),
+			spent:  DefaultDodgeConfig().MaxDodges,
+			action: DodgeTank,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := NewDodgeSystem(DefaultDodgeConfig(), nil)
+			for i := 0; i < tt.spent; i++ {
+				ds.recentDodges = append(ds.recentDodges, 0)
+			}
+			ctx := baseCtx
+			if tt.ctx != nil {
+				tt.ctx(&ctx)
+			}
+
+			impact, hits := ds.timeToImpact(ctx.Hurtbox, utils.Zero(), tt.proj)
+			if !hits {
+				t.Fatal("el proyectil de prueba debería impactar")
+			}
+
+			decision := ds.choose(&ctx, tt.proj, impact)
+			if decision.Action != tt.action {
+				t.Fatalf("Action = %v, se esperaba %v", decision.Action, tt.action)
+			}
+			if tt.action == DodgeStep && decision.Direction != tt.direction {
+				t.Errorf("Direction = %v, se esperaba %v", decision.Direction, tt.direction)
+			}
+			if decision.TimeToImpact != impact {
+				t.Errorf("TimeToImpact = %v, se esperaba %v", decision.TimeToImpact, impact)
+			}
+		})
+	}
+}
+
+func TestDodgeReactionDelay(t *testing.T) {
+	ds := NewDodgeSystem(DefaultDodgeConfig(), nil)
+	ds.SetDifficulty(3)
+	ctx := DodgeContext{Dodger: combat.BossActor, Hurtbox: dodgeHurtbox, OnGround: true, CanAct: true, MaxX: 1000}
+
+	// Lejos pero dentro de LookAhead: hay tiempo de sobra para reaccionar
+	proj := testProjectile(utils.NewVector2(110, -100), utils.NewVector2(0, 5))
+	list := []*projectiles.Projectile{proj}
+
+	// El primer frame la detecta; reacciona cuando pasaron ReactionFrames desde ahí
+	reaction := DefaultDodgeConfig().ReactionFrames[2]
+	for frame := 0; frame < reaction; frame++ {
+		if got := ds.Update(&ctx, list).Action; got != DodgeNone {
+			t.Fatalf("frame %d: reaccionó (%v) antes del tiempo de reacción", frame, got)
+		}
+		proj.Position = proj.Position.Add(proj.Velocity)
+	}
+	if got := ds.Update(&ctx, list).Action; got != DodgeStep {
+		t.Fatalf("tras %d frames de reacción debería esquivar, eligió %v", reaction, got)
+	}
+
+	// Ya resuelta: no vuelve a gastar esquivas en la misma amenaza
+	if got := ds.Update(&ctx, list).Action; got != DodgeNone {
+		t.Errorf("una amenaza ya resuelta no debería repetir la esquiva (eligió %v)", got)
+	}
+	if left := ds.DodgesLeft(); left != DefaultDodgeConfig().MaxDodges-1 {
+		t.Errorf("DodgesLeft = %d, se esperaba %d", left, DefaultDodgeConfig().MaxDodges-1)
+	}
+}
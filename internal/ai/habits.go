@@ -0,0 +1,143 @@
+// internal/ai/habits.go
+package ai
+
+import "math"
+
+// ============================================================================
+// HÁBITOS DEL JUGADOR
+// ============================================================================
+
+// Habit es un patrón de juego que el boss aprende a castigar
+type Habit int
+
+const (
+	HabitPogo        Habit = iota // Rebotar sobre el boss
+	HabitRangedShots              // Disparar desde lejos
+	HabitDashThrough              // Atravesar la carga con el dash
+	HabitWallCamping              // Quedarse pegado a una pared
+	HabitCount                    // Cantidad de hábitos (no es un hábito)
+)
+
+// String retorna el nombre del hábito
+func (h Habit) String() string {
+	switch h {
+	case HabitPogo:
+		return "Pogo"
+	case HabitRangedShots:
+		return "Disparos a distancia"
+	case HabitDashThrough:
+		return "Dash a través de la carga"
+	case HabitWallCamping:
+		return "Acampar en la pared"
+	default:
+		return "Unknown"
+	}
+}
+
+// Habits son las tendencias actuales del jugador (0..1, índice = Habit)
+type Habits [HabitCount]float64
+
+// HabitConfig contiene la configuración del modelo de hábitos
+type HabitConfig struct {
+	HalfLife   int     // Frames para que una observación valga la mitad
+	Saturation float64 // Peso acumulado con el que la tendencia llega a 0.5
+}
+
+// DefaultHabitConfig retorna la configuración por defecto
+func DefaultHabitConfig() HabitConfig {
+	return HabitConfig{
+		HalfLife:   1200, // 20 segundos
+		Saturation: 5.0,
+	}
+}
+
+// HabitModel lleva un modelo del comportamiento reciente del jugador.
+// Cada observación suma peso y el peso se desvanece con el tiempo, así que
+// si el jugador cambia de estrategia el boss también se adapta.
+// Se usa desde el hilo del juego (no es thread-safe).
+type HabitModel struct {
+	config HabitConfig
+	decay  float64 // Multiplicador por frame
+
+	weights [HabitCount]float64
+	counts  [HabitCount]int     // Observaciones en la pelea
+	peaks   [HabitCount]float64 // Tendencia máxima alcanzada (resumen final)
+}
+
+// NewHabitModel crea un modelo de hábitos vacío
+func NewHabitModel(cfg HabitConfig) *HabitModel {
+	decay := 1.0
+	if cfg.HalfLife > 0 {
+		decay = math.Pow(0.5, 1/float64(cfg.HalfLife))
+	}
+	if cfg.Saturation <= 0 {
+		cfg.Saturation = 1
+	}
+
+	return &HabitModel{
+		config: cfg,
+		decay:  decay,
+	}
+}
+
+// Observe registra que el jugador repitió un hábito (amount = cuánto pesa)
+func (hm *HabitModel) Observe(habit Habit, amount float64) {
+	if habit < 0 || habit >= HabitCount {
+		return
+	}
+
+	hm.weights[habit] += amount
+	hm.counts[habit]++
+
+	if tendency := hm.Tendency(habit); tendency > hm.peaks[habit] {
+		hm.peaks[habit] = tendency
+	}
+}
+
+// Update desvanece las observaciones viejas (llamar cada frame)
+func (hm *HabitModel) Update() {
+	for i := range hm.weights {
+		hm.weights[i] *= hm.decay
+	}
+}
+
+// Tendency retorna qué tan marcado es un hábito ahora mismo (0..1)
+func (hm *HabitModel) Tendency(habit Habit) float64 {
+	if habit < 0 || habit >= HabitCount {
+		return 0
+	}
+	weight := hm.weights[habit]
+	return weight / (weight + hm.config.Saturation)
+}
+
+// Tendencies retorna todas las tendencias actuales
+func (hm *HabitModel) Tendencies() Habits {
+	var habits Habits
+	for i := range habits {
+		habits[i] = hm.Tendency(Habit(i))
+	}
+	return habits
+}
+
+// Count retorna cuántas veces se observó un hábito en la pelea
+func (hm *HabitModel) Count(habit Habit) int {
+	if habit < 0 || habit >= HabitCount {
+		return 0
+	}
+	return hm.counts[habit]
+}
+
+// Peak retorna la tendencia máxima que alcanzó un hábito en la pelea
+func (hm *HabitModel) Peak(habit Habit) float64 {
+	if habit < 0 || habit >= HabitCount {
+		return 0
+	}
+	return hm.peaks[habit]
+}
+
+// Reset olvida todo (al reiniciar la pelea)
+func (hm *HabitModel) Reset() {
+	hm.weights = [HabitCount]float64{}
+	hm.counts = [HabitCount]int{}
+	hm.peaks = [HabitCount]float64{}
+}
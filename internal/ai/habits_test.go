@@ -0,0 +1,181 @@
+package ai
+
+import "testing"
+
+func TestHabitModelDecay(t *testing.T) {
+	tests := []struct {
+		name     string
+		halfLife int
+		frames   int
+		want     float64 // Peso que queda de una observación de 4
+	}{
+		{"sin tiempo", 60, 0, 4},
+		{"una vida media", 60, 60, 2},
+		{"dos vidas medias", 60, 120, 1},
+		{"sin vida media no se desvanece", 0, 600, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hm := NewHabitModel(HabitConfig{HalfLife: tt.halfLife, Saturation: 4})
+			hm.Observe(HabitPogo, 4)
+			for i := 0; i < tt.frames; i++ {
+				hm.Update()
+			}
+
+			// Tendencia = peso / (peso + saturación)
+			want := tt.want / (tt.want + 4)
+			if got := hm.Tendency(HabitPogo); !almostEqual(got, want) {
+				t.Errorf("Tendency = %v, se esperaba %v", got, want)
+			}
+		})
+	}
+}
+
+func TestHabitModelSaturation(t *testing.T) {
+	tests := []struct {
+		name       string
+		saturation float64
+		observed   float64
+		want       float64
+	}{
+		{"sin observaciones", 5, 0, 0},
+		{"peso igual a la saturación: la mitad", 5, 5, 0.5},
+		{"el triple: tres cuartos", 5, 15, 0.75},
+		{"nunca llega a 1", 5, 1e6, 1e6 / (1e6 + 5)},
+		{"saturación inválida usa 1", 0, 1, 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hm := NewHabitModel(HabitConfig{HalfLife: 600, Saturation: tt.saturation})
+			if tt.observed > 0 {
+				hm.Observe(HabitRangedShots, tt.observed)
+			}
+
+			got := hm.Tendency(HabitRangedShots)
+			if !almostEqual(got, tt.want) {
+				t.Errorf("Tendency = %v, se esperaba %v", got, tt.want)
+			}
+			if got >= 1 {
+				t.Errorf("Tendency = %v, debería quedar por debajo de 1", got)
+			}
+		})
+	}
+}
+
+func TestHabitModelPeakAndCount(t *testing.T) {
+	hm := NewHabitModel(HabitConfig{HalfLife: 10, Saturation: 1})
+	hm.Observe(HabitWallCamping, 1)
+	hm.Observe(HabitWallCamping, 1)
+	peak := hm.Tendency(HabitWallCamping)
+
+	for i := 0; i < 100; i++ {
+		hm.Update()
+	}
+
+	// La tendencia se desvanece pero el pico y el conteo quedan para el resumen
+	if hm.Tendency(HabitWallCamping) >= peak {
+		t.Errorf("la tendencia debería haber bajado de %v", peak)
+	}
+	if got := hm.Peak(HabitWallCamping); !almostEqual(got, peak) {
+		t.Errorf("Peak = %v, se esperaba %v", got, peak)
+	}
+	if got := hm.Count(HabitWallCamping); got != 2 {
+		t.Errorf("Count = %d, se esperaba 2", got)
+	}
+
+	// Hábitos fuera de rango se ignoran
+	hm.Observe(HabitCount, 10)
+	if hm.Tendency(HabitCount) != 0 || hm.Count(-1) != 0 || hm.Peak(HabitCount) != 0 {
+		t.Error("un hábito fuera de rango no debería registrarse")
+	}
+
+	hm.Reset()
+	if hm.Tendencies() != (Habits{}) || hm.Count(HabitWallCamping) != 0 || hm.Peak(HabitWallCamping) != 0 {
+		t.Error("Reset debería olvidar todo")
+	}
+}
+
+func TestUtilityAdaptation(t *testing.T) {
+	// MaxAdaptation por defecto: ±50%
+	tests := []struct {
+		name     string
+		counters []HabitCounter
+		habits   Habits
+		want     float64
+	}{
+		{
+			name:     "sin hábitos",
+			counters: []HabitCounter{{Habit: HabitPogo, Strength: 0.6}},
+			want:     1,
+		},
+		{
+			name:     "castiga el hábito",
+			counters: []HabitCounter{{Habit: HabitPogo, Strength: 0.6}},
+			habits:   Habits{HabitPogo: 0.5},
+			want:     1.3,
+		},
+		{
+			name:     "lo evita (fuerza negativa)",
+			counters: []HabitCounter{{Habit: HabitRangedShots, Strength: -0.4}},
+			habits:   Habits{HabitRangedShots: 0.5},
+			want:     0.8,
+		},
+		{
+			name: "los contadores se suman",
+			counters: []HabitCounter{
+				{Habit: HabitPogo, Strength: 0.2},
+				{Habit: HabitDashThrough, Strength: 0.2},
+			},
+			habits: Habits{HabitPogo: 0.5, HabitDashThrough: 0.5},
+			want:   1.2,
+		},
+		{
+			name:     "tope por arriba",
+			counters: []HabitCounter{{Habit: HabitPogo, Strength: 2}},
+			habits:   Habits{HabitPogo: 0.9},
+			want:     1.5,
+		},
+		{
+			name:     "tope por abajo",
+			counters: []HabitCounter{{Habit: HabitPogo, Strength: -2}},
+			habits:   Habits{HabitPogo: 0.9},
+			want:     0.5,
+		},
+		{
+			name:     "hábito inválido se ignora",
+			counters: []HabitCounter{{Habit: HabitCount, Strength: 1}},
+			want:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			move := UtilityMove{Name: "a", Considerations: []Consideration{fixed("x", 1, 1)}, Counters: tt.counters}
+			us := NewUtilitySystem(testUtilityConfig(), []UtilityMove{move}, nil)
+
+			got := us.score(&move, &UtilityContext{Habits: tt.habits})
+			if !almostEqual(got.Adaptation, tt.want) {
+				t.Errorf("Adaptation = %v, se esperaba %v", got.Adaptation, tt.want)
+			}
+			if !almostEqual(got.Score, tt.want) {
+				t.Errorf("Score = %v, se esperaba %v", got.Score, tt.want)
+			}
+		})
+	}
+}
+
+func TestUtilityCountersFor(t *testing.T) {
+	moves := []UtilityMove{
+		{Name: "anti_pogo", Counters: []HabitCounter{{Habit: HabitPogo, Strength: 0.5}}},
+		{Name: "avoids_pogo", Counters: []HabitCounter{{Habit: HabitPogo, Strength: -0.5}}},
+		{Name: "anti_ranged", Counters: []HabitCounter{{Habit: HabitRangedShots, Strength: 0.5}}},
+	}
+	us := NewUtilitySystem(testUtilityConfig(), moves, nil)
+
+	got := us.CountersFor(HabitPogo)
+	if len(got) != 1 || got[0] != "anti_pogo" {
+		t.Errorf("CountersFor(Pogo) = %v, se esperaba [anti_pogo]", got)
+	}
+}
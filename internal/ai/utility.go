@@ -0,0 +1,349 @@
+// internal/ai/utility.go
+package ai
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// ============================================================================
+// CONTEXTO
+// ============================================================================
+
+// UtilityContext es la foto de la situación con la que se puntúan los movimientos
+type UtilityContext struct {
+	Phase int // 0 = Fase 1
+
+	// Distancias al objetivo
+	Distance           float64
+	HorizontalDistance float64
+
+	// Objetivo
+	TargetAirborne  bool
+	TargetStamina   float64 // 0..1
+	TargetCornered  float64 // 0 = en el centro, 1 = contra la pared
+	TargetAttacking bool
+	TargetCombo     int // Golpes encadenados por el jugador
+
+	// Propio
+	HealthPercent float64 // 0..1
+	Cornered      float64 // 0 = en el centro, 1 = contra la pared
+	Minions       int     // Minions vivos
+
+	// Hábitos aprendidos del jugador
+	Habits Habits
+}
+
+// ============================================================================
+// CURVAS DE RESPUESTA (todas retornan un valor en [0, 1])
+// ============================================================================
+
+// Linear sube de 0 (en min) a 1 (en max)
+func Linear(x, min, max float64) float64 {
+	if max == min {
+		if x >= max {
+			return 1
+		}
+		return 0
+	}
+	return clamp01((x - min) / (max - min))
+}
+
+// InverseLinear baja de 1 (en min) a 0 (en max)
+func InverseLinear(x, min, max float64) float64 {
+	return 1 - Linear(x, min, max)
+}
+
+// Band vale 1 dentro de [min, max] y cae linealmente a 0 a "falloff" de distancia
+func Band(x, min, max, falloff float64) float64 {
+	switch {
+	case x < min:
+		return InverseLinear(min-x, 0, falloff)
+	case x > max:
+		return InverseLinear(x-max, 0, falloff)
+	default:
+		return 1
+	}
+}
+
+// Bool convierte una condición en 0 o 1
+func Bool(condition bool) float64 {
+	if condition {
+		return 1
+	}
+	return 0
+}
+
+func clamp01(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	if x > 1 {
+		return 1
+	}
+	return x
+}
+
+// ============================================================================
+// MOVIMIENTOS
+// ============================================================================
+
+// Consideration es un factor que puntúa un movimiento
+type Consideration struct {
+	Name   string
+	Weight float64 // Importancia relativa dentro del movimiento
+	Score  func(ctx *UtilityContext) float64
+}
+
+// UtilityMove es una acción que la IA puede elegir
+type UtilityMove struct {
+	Name string
+
+	// Available retorna false si está en cooldown o no se puede usar (nil = siempre)
+	Available func(ctx *UtilityContext) bool
+
+	Considerations []Consideration
+
+	// Counters son los hábitos que este movimiento castiga (o evita, si Strength < 0)
+	Counters []HabitCounter
+
+	// Multiplicador por fase (índice = fase). Fases sin entrada usan 1.
+	PhaseWeights []float64
+
+	// Repeatable evita la penalización por repetir (moverse, esperar)
+	Repeatable bool
+}
+
+// HabitCounter relaciona un movimiento con un hábito del jugador
+type HabitCounter struct {
+	Habit    Habit
+	Strength float64 // Cuánto sube (o baja) la puntuación con la tendencia al máximo
+}
+
+// phaseWeight retorna el multiplicador del movimiento en una fase
+func (m *UtilityMove) phaseWeight(phase int) float64 {
+	if phase < 0 || phase >= len(m.PhaseWeights) {
+		return 1
+	}
+	return m.PhaseWeights[phase]
+}
+
+// ============================================================================
+// RESULTADO (para el overlay de debug)
+// ============================================================================
+
+// FactorScore es el valor de una consideración
+type FactorScore struct {
+	Name  string
+	Value float64
+}
+
+// MoveScore es la puntuación de un movimiento y cómo se obtuvo
+type MoveScore struct {
+	Name        string
+	Available   bool
+	Factors     []FactorScore
+	Base        float64 // Media ponderada de los factores
+	PhaseWeight float64
+	Repetition  float64 // Multiplicador por uso reciente (1 = sin penalización)
+	Adaptation  float64 // Multiplicador por hábitos del jugador (1 = sin sesgo)
+	Score       float64
+}
+
+// UtilityDecision es el resultado de una evaluación
+type UtilityDecision struct {
+	Chosen string
+	Scores []MoveScore // Ordenadas de mayor a menor
+}
+
+// ============================================================================
+// SISTEMA
+// ============================================================================
+
+// UtilityConfig contiene la configuración de la IA
+type UtilityConfig struct {
+	MemorySize    int     // Cuántas decisiones recientes recuerda
+	RepeatPenalty float64 // Penalización máxima por repetir (0..1)
+	Jitter        float64 // Ruido aleatorio para desempatar (0..1)
+	MaxAdaptation float64 // Tope del sesgo por hábitos (0.5 = ±50%), para que sea justo
+}
+
+// DefaultUtilityConfig retorna la configuración por defecto
+func DefaultUtilityConfig() UtilityConfig {
+	return UtilityConfig{
+		MemorySize:    4,
+		RepeatPenalty: 0.5,
+		Jitter:        0.05,
+		MaxAdaptation: 0.5,
+	}
+}
+
+// UtilitySystem elige el movimiento con mejor puntuación según el contexto.
+// Se usa desde el hilo del juego (no es thread-safe).
+type UtilitySystem struct {
+	config UtilityConfig
+	moves  []UtilityMove
+	rng    *rand.Rand
+
+	// Memoria de decisiones recientes (para no repetir)
+	recent []string
+
+	// Última evaluación (debug)
+	last UtilityDecision
+}
+
+// NewUtilitySystem crea la IA con sus movimientos
+func NewUtilitySystem(cfg UtilityConfig, moves []UtilityMove, rng *rand.Rand) *UtilitySystem {
+	return &UtilitySystem{
+		config: cfg,
+		moves:  moves,
+		rng:    rng,
+		recent: make([]string, 0, cfg.MemorySize),
+	}
+}
+
+// Decide puntúa todos los movimientos y retorna el nombre del mejor
+// ("" si ninguno está disponible)
+func (us *UtilitySystem) Decide(ctx *UtilityContext) string {
+	scores := make([]MoveScore, 0, len(us.moves))
+
+	for i := range us.moves {
+		scores = append(scores, us.score(&us.moves[i], ctx))
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Available != scores[j].Available {
+			return scores[i].Available
+		}
+		return scores[i].Score > scores[j].Score
+	})
+
+	chosen := ""
+	if len(scores) > 0 && scores[0].Available && scores[0].Score > 0 {
+		chosen = scores[0].Name
+		if move := us.move(chosen); move != nil && !move.Repeatable {
+			us.remember(chosen)
+		}
+	}
+
+	us.last = UtilityDecision{Chosen: chosen, Scores: scores}
+	return chosen
+}
+
+// score evalúa un movimiento
+func (us *UtilitySystem) score(move *UtilityMove, ctx *UtilityContext) MoveScore {
+	result := MoveScore{
+		Name:        move.Name,
+		Available:   move.Available == nil || move.Available(ctx),
+		Factors:     make([]FactorScore, 0, len(move.Considerations)),
+		PhaseWeight: move.phaseWeight(ctx.Phase),
+		Repetition:  1,
+		Adaptation:  us.adaptation(move, &ctx.Habits),
+	}
+	if !move.Repeatable {
+		result.Repetition = us.repetition(move.Name)
+	}
+
+	weighted, totalWeight := 0.0, 0.0
+	for _, consideration := range move.Considerations {
+		value := clamp01(consideration.Score(ctx))
+		result.Factors = append(result.Factors, FactorScore{Name: consideration.Name, Value: value})
+		weighted += value * consideration.Weight
+		totalWeight += consideration.Weight
+	}
+	if totalWeight > 0 {
+		result.Base = weighted / totalWeight
+	}
+
+	if !result.Available {
+		return result
+	}
+
+	jitter := 1.0
+	if us.config.Jitter > 0 && us.rng != nil {
+		jitter += (us.rng.Float64()*2 - 1) * us.config.Jitter
+	}
+	result.Score = result.Base * result.PhaseWeight * result.Repetition * result.Adaptation * jitter
+	return result
+}
+
+// adaptation retorna el multiplicador del movimiento según los hábitos del jugador
+func (us *UtilitySystem) adaptation(move *UtilityMove, habits *Habits) float64 {
+	bias := 0.0
+	for _, counter := range move.Counters {
+		if counter.Habit >= 0 && counter.Habit < HabitCount {
+			bias += habits[counter.Habit] * counter.Strength
+		}
+	}
+
+	limit := us.config.MaxAdaptation
+	if bias > limit {
+		bias = limit
+	}
+	if bias < -limit {
+		bias = -limit
+	}
+	return 1 + bias
+}
+
+// CountersFor retorna los movimientos que castigan un hábito
+func (us *UtilitySystem) CountersFor(habit Habit) []string {
+	var names []string
+	for _, move := range us.moves {
+		for _, counter := range move.Counters {
+			if counter.Habit == habit && counter.Strength > 0 {
+				names = append(names, move.Name)
+				break
+			}
+		}
+	}
+	return names
+}
+
+// move busca un movimiento por nombre
+func (us *UtilitySystem) move(name string) *UtilityMove {
+	for i := range us.moves {
+		if us.moves[i].Name == name {
+			return &us.moves[i]
+		}
+	}
+	return nil
+}
+
+// repetition retorna el multiplicador por haber usado el movimiento hace poco
+func (us *UtilitySystem) repetition(name string) float64 {
+	if us.config.MemorySize == 0 {
+		return 1
+	}
+
+	uses := 0
+	for _, recent := range us.recent {
+		if recent == name {
+			uses++
+		}
+	}
+	return 1 - us.config.RepeatPenalty*float64(uses)/float64(us.config.MemorySize)
+}
+
+// remember guarda una decisión en la memoria reciente
+func (us *UtilitySystem) remember(name string) {
+	if us.config.MemorySize == 0 {
+		return
+	}
+	if len(us.recent) >= us.config.MemorySize {
+		copy(us.recent, us.recent[1:])
+		us.recent = us.recent[:len(us.recent)-1]
+	}
+	us.recent = append(us.recent, name)
+}
+
+// Forget borra la memoria reciente (al reiniciar la pelea)
+func (us *UtilitySystem) Forget() {
+	us.recent = us.recent[:0]
+	us.last = UtilityDecision{}
+}
+
+// LastDecision retorna la última evaluación (para el overlay de debug)
+func (us *UtilitySystem) LastDecision() UtilityDecision {
+	return us.last
+}
@@ -0,0 +1,201 @@
+package ai
+
+import (
+	"math"
+	"testing"
+)
+
+// testUtilityConfig es la configuración por defecto sin ruido (puntuaciones exactas)
+func testUtilityConfig() UtilityConfig {
+	cfg := DefaultUtilityConfig()
+	cfg.Jitter = 0
+	return cfg
+}
+
+// fixed es una consideración con valor constante
+func fixed(name string, weight, value float64) Consideration {
+	return Consideration{Name: name, Weight: weight, Score: func(*UtilityContext) float64 { return value }}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestResponseCurves(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"Linear debajo del mínimo", Linear(-5, 0, 10), 0},
+		{"Linear a mitad", Linear(5, 0, 10), 0.5},
+		{"Linear encima del máximo", Linear(20, 0, 10), 1},
+		{"Linear escalón (min == max), antes", Linear(4, 5, 5), 0},
+		{"Linear escalón (min == max), en el punto", Linear(5, 5, 5), 1},
+		{"InverseLinear a un cuarto", InverseLinear(2.5, 0, 10), 0.75},
+		{"Band dentro", Band(150, 100, 200, 50), 1},
+		{"Band cae por debajo", Band(75, 100, 200, 50), 0.5},
+		{"Band cae por encima", Band(225, 100, 200, 50), 0.5},
+		{"Band fuera del falloff", Band(300, 100, 200, 50), 0},
+		{"Bool", Bool(true) + Bool(false), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !almostEqual(tt.got, tt.want) {
+				t.Errorf("= %v, se esperaba %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUtilityScoring(t *testing.T) {
+	tests := []struct {
+		name  string
+		move  UtilityMove
+		phase int
+		base  float64
+		score float64
+	}{
+		{
+			name:  "media ponderada",
+			move:  UtilityMove{Name: "a", Considerations: []Consideration{fixed("x", 3, 1), fixed("y", 1, 0)}},
+			base:  0.75,
+			score: 0.75,
+		},
+		{
+			name:  "los factores se acotan a [0, 1]",
+			move:  UtilityMove{Name: "a", Considerations: []Consideration{fixed("x", 1, 4), fixed("y", 1, -2)}},
+			base:  0.5,
+			score: 0.5,
+		},
+		{
+			name:  "peso de la fase",
+			move:  UtilityMove{Name: "a", Considerations: []Consideration{fixed("x", 1, 0.5)}, PhaseWeights: []float64{1, 1.6}},
+			phase: 1,
+			base:  0.5,
+			score: 0.8,
+		},
+		{
+			name:  "fase sin peso definido usa 1",
+			move:  UtilityMove{Name: "a", Considerations: []Consideration{fixed("x", 1, 0.5)}, PhaseWeights: []float64{2}},
+			phase: 2,
+			base:  0.5,
+			score: 0.5,
+		},
+		{
+			name:  "no disponible: base calculada, puntuación cero",
+			move:  UtilityMove{Name: "a", Considerations: []Consideration{fixed("x", 1, 1)}, Available: func(*UtilityContext) bool { return false }},
+			base:  1,
+			score: 0,
+		},
+		{
+			name:  "sin consideraciones",
+			move:  UtilityMove{Name: "a"},
+			base:  0,
+			score: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			us := NewUtilitySystem(testUtilityConfig(), []UtilityMove{tt.move}, nil)
+			got := us.score(&tt.move, &UtilityContext{Phase: tt.phase})
+
+			if !almostEqual(got.Base, tt.base) {
+				t.Errorf("Base = %v, se esperaba %v", got.Base, tt.base)
+			}
+			if !almostEqual(got.Score, tt.score) {
+				t.Errorf("Score = %v, se esperaba %v", got.Score, tt.score)
+			}
+		})
+	}
+}
+
+func TestUtilityDecideSkipsUnavailable(t *testing.T) {
+	moves := []UtilityMove{
+		{Name: "best", Considerations: []Consideration{fixed("x", 1, 1)}, Available: func(*UtilityContext) bool { return false }},
+		{Name: "ok", Considerations: []Consideration{fixed("x", 1, 0.4)}},
+		{Name: "zero", Considerations: []Consideration{fixed("x", 1, 0)}},
+	}
+	us := NewUtilitySystem(testUtilityConfig(), moves, nil)
+
+	if got := us.Decide(&UtilityContext{}); got != "ok" {
+		t.Fatalf("Decide = %q, se esperaba %q", got, "ok")
+	}
+
+	// Las no disponibles quedan al final del ranking
+	scores := us.LastDecision().Scores
+	if last := scores[len(scores)-1]; last.Name != "best" || last.Available {
+		t.Errorf("último del ranking %+v, se esperaba el no disponible", last)
+	}
+
+	// Sin nada con puntuación positiva no se elige nada
+	us = NewUtilitySystem(testUtilityConfig(), moves[2:], nil)
+	if got := us.Decide(&UtilityContext{}); got != "" {
+		t.Errorf("Decide = %q, se esperaba ninguno", got)
+	}
+}
+
+func TestUtilityRepetitionPenalty(t *testing.T) {
+	// MemorySize 4, RepeatPenalty 0.5: cada uso reciente resta 12.5%
+	cfg := testUtilityConfig()
+
+	tests := []struct {
+		name       string
+		repeatable bool
+		decisions  int
+		want       float64
+	}{
+		{"sin usos", false, 0, 1},
+		{"un uso", false, 1, 0.875},
+		{"memoria llena", false, 4, 0.5},
+		{"la memoria no pasa de MemorySize", false, 10, 0.5},
+		{"repetible: sin penalización", true, 4, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			move := UtilityMove{Name: "a", Considerations: []Consideration{fixed("x", 1, 1)}, Repeatable: tt.repeatable}
+			us := NewUtilitySystem(cfg, []UtilityMove{move}, nil)
+			for i := 0; i < tt.decisions; i++ {
+				us.Decide(&UtilityContext{})
+			}
+
+			got := us.score(&move, &UtilityContext{})
+			if !almostEqual(got.Repetition, tt.want) {
+				t.Errorf("Repetition = %v, se esperaba %v", got.Repetition, tt.want)
+			}
+			if !almostEqual(got.Score, tt.want) {
+				t.Errorf("Score = %v, se esperaba %v", got.Score, tt.want)
+			}
+		})
+	}
+}
+
+func TestUtilityAlternatesUnderPenalty(t *testing.T) {
+	// "a" es un poco mejor, pero repetirla la hunde por debajo de "b"
+	moves := []UtilityMove{
+		{Name: "a", Considerations: []Consideration{fixed("x", 1, 0.9)}},
+		{Name: "b", Considerations: []Consideration{fixed("x", 1, 0.8)}},
+	}
+	us := NewUtilitySystem(testUtilityConfig(), moves, nil)
+
+	var got []string
+	for i := 0; i < 4; i++ {
+		got = append(got, us.Decide(&UtilityContext{}))
+	}
+
+	want := []string{"a", "b", "a", "b"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("decisiones %v, se esperaba %v", got, want)
+		}
+	}
+
+	// Forget borra la memoria: vuelve a elegir la mejor
+	us.Forget()
+	if decision := us.Decide(&UtilityContext{}); decision != "a" {
+		t.Errorf("tras Forget eligió %q, se esperaba %q", decision, "a")
+	}
+}
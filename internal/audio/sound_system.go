@@ -16,6 +16,8 @@ const (
 	SoundPlayerHurt
 	SoundVictory
 	SoundGameOver
+	SoundTelegraph // Aviso de ataque del boss
+	SoundPickup    // Recoger un orbe
 )
 
 // SoundSystem maneja la reproducción de sonidos (THREAD-SAFE)
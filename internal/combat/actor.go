@@ -0,0 +1,135 @@
+package combat
+
+import "fmt"
+
+// ============================================================================
+// FACCIONES
+// ============================================================================
+
+// Faction representa el bando de un actor
+type Faction int
+
+const (
+	FactionNeutral Faction = iota // Entorno (burn, trampas): no es hostil a nadie
+	FactionPlayer                 // Jugadores y sus aliados
+	FactionEnemy                  // Bosses y minions
+)
+
+// String retorna el nombre de la facción
+func (f Faction) String() string {
+	switch f {
+	case FactionNeutral:
+		return "Neutral"
+	case FactionPlayer:
+		return "Player"
+	case FactionEnemy:
+		return "Enemy"
+	default:
+		return "Unknown"
+	}
+}
+
+// Relation representa cómo se tratan dos facciones
+type Relation int
+
+const (
+	RelationNeutral Relation = iota // No se dañan
+	RelationAlly                    // No se dañan (fuego amigo desactivado)
+	RelationHostile                 // Se dañan
+)
+
+// factionRelations es la tabla de relaciones [atacante][objetivo]
+var factionRelations = [...][3]Relation{
+	FactionNeutral: {RelationAlly, RelationNeutral, RelationNeutral},
+	FactionPlayer:  {RelationNeutral, RelationAlly, RelationHostile},
+	FactionEnemy:   {RelationNeutral, RelationHostile, RelationAlly},
+}
+
+// RelationBetween retorna la relación de una facción atacante con una objetivo
+func RelationBetween(attacker, target Faction) Relation {
+	if attacker < 0 || int(attacker) >= len(factionRelations) ||
+		target < 0 || int(target) >= len(factionRelations[attacker]) {
+		return RelationNeutral
+	}
+	return factionRelations[attacker][target]
+}
+
+// ============================================================================
+// IDENTIDAD DE ACTORES
+// ============================================================================
+
+// ActorKind representa el tipo de entidad
+type ActorKind int
+
+const (
+	ActorKindNone   ActorKind = iota // Sin actor (entorno, efectos de estado)
+	ActorKindPlayer                  // Jugador
+	ActorKindBoss                    // Boss
+	ActorKindMinion                  // Minion
+)
+
+// String retorna el nombre del tipo de actor
+func (k ActorKind) String() string {
+	switch k {
+	case ActorKindNone:
+		return "none"
+	case ActorKindPlayer:
+		return "player"
+	case ActorKindBoss:
+		return "boss"
+	case ActorKindMinion:
+		return "minion"
+	default:
+		return "unknown"
+	}
+}
+
+// ActorID identifica a una entidad concreta (comparable, se puede usar como clave de map)
+type ActorID struct {
+	Kind    ActorKind
+	Index   int // 0 = primero de su tipo (player 2 = Index 1)
+	Faction Faction
+}
+
+// Actores por defecto de la pelea 1v1
+var (
+	NoActor     = ActorID{}
+	PlayerActor = ActorID{Kind: ActorKindPlayer, Faction: FactionPlayer}
+	BossActor   = ActorID{Kind: ActorKindBoss, Faction: FactionEnemy}
+)
+
+// NewActorID crea un ID de actor
+func NewActorID(kind ActorKind, index int, faction Faction) ActorID {
+	return ActorID{Kind: kind, Index: index, Faction: faction}
+}
+
+// String retorna el nombre para logs ("player", "boss", "minion#3")
+func (id ActorID) String() string {
+	if id.Index == 0 {
+		return id.Kind.String()
+	}
+	return fmt.Sprintf("%s#%d", id.Kind, id.Index+1)
+}
+
+// MarshalText exporta el actor por nombre (JSON)
+func (id ActorID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// IsNone retorna true si no hay actor (ej. daño del entorno)
+func (id ActorID) IsNone() bool {
+	return id.Kind == ActorKindNone
+}
+
+// IsPlayer retorna true si el actor es un jugador
+func (id ActorID) IsPlayer() bool {
+	return id.Kind == ActorKindPlayer
+}
+
+// CanHarm retorna true si el atacante puede dañar al objetivo según sus facciones
+func CanHarm(attacker, target ActorID) bool {
+	if attacker == target {
+		return false
+	}
+	return RelationBetween(attacker.Faction, target.Faction) == RelationHostile
+}
@@ -0,0 +1,206 @@
+package combat
+
+import "github.com/MarcosBrindis/boss-arena-go/internal/utils"
+
+// ============================================================================
+// FRAME DATA
+// ============================================================================
+
+// AttackPhase representa la fase de un ataque en curso
+type AttackPhase int
+
+const (
+	AttackPhaseNone     AttackPhase = iota // Sin ataque
+	AttackPhaseStartup                     // Anticipación (sin hitbox)
+	AttackPhaseActive                      // Hitbox activo
+	AttackPhaseRecovery                    // Recuperación (vulnerable)
+)
+
+// String retorna el nombre de la fase
+func (ap AttackPhase) String() string {
+	switch ap {
+	case AttackPhaseNone:
+		return "None"
+	case AttackPhaseStartup:
+		return "Startup"
+	case AttackPhaseActive:
+		return "Active"
+	case AttackPhaseRecovery:
+		return "Recovery"
+	default:
+		return "Unknown"
+	}
+}
+
+// HitboxShape es un rectángulo relativo al centro del atacante MIRANDO A LA DERECHA.
+// Al mirar a la izquierda se refleja en X.
+type HitboxShape struct {
+	OffsetX float64 // Esquina izquierda relativa al centro
+	OffsetY float64 // Esquina superior relativa al centro
+	Width   float64
+	Height  float64
+}
+
+// Resolve convierte la forma en un rectángulo del mundo
+func (hs HitboxShape) Resolve(origin utils.Vector2, facingRight bool) utils.Rectangle {
+	x := origin.X + hs.OffsetX
+	if !facingRight {
+		x = origin.X - hs.OffsetX - hs.Width
+	}
+
+	return utils.NewRectangle(x, origin.Y+hs.OffsetY, hs.Width, hs.Height)
+}
+
+// CancelWindow es un rango de frames [Start, End] en que el ataque se puede cancelar
+type CancelWindow struct {
+	Start int
+	End   int
+}
+
+// AttackDefinition describe un ataque con su frame data
+type AttackDefinition struct {
+	Name string // Nombre para eventos ("player_attack", "boss_slam"...)
+
+	// Frame data
+	Startup  int
+	Active   int
+	Recovery int
+
+	// Un hitbox por frame activo (si hay menos, se repite el último)
+	Hitboxes []HitboxShape
+
+	// Golpe
+	Damage     int
+	DamageType DamageType
+	Knockback  float64
+	CritChance float64
+	Parryable  bool
+
+	// Desgaste de postura por punto de daño (0 = 1x)
+	PoiseDamage float64
+
+	// Interrumpe los movimientos sin armadura del objetivo (flinch)
+	Interrupts bool
+
+	// Frames en que se puede cancelar en otra acción
+	CancelWindows []CancelWindow
+}
+
+// TotalFrames retorna la duración total del ataque
+func (ad *AttackDefinition) TotalFrames() int {
+	return ad.Startup + ad.Active + ad.Recovery
+}
+
+// PhaseAt retorna la fase del ataque en un frame (desde 0)
+func (ad *AttackDefinition) PhaseAt(frame int) AttackPhase {
+	switch {
+	case frame < 0 || frame >= ad.TotalFrames():
+		return AttackPhaseNone
+	case frame < ad.Startup:
+		return AttackPhaseStartup
+	case frame < ad.Startup+ad.Active:
+		return AttackPhaseActive
+	default:
+		return AttackPhaseRecovery
+	}
+}
+
+// HitboxAt retorna el hitbox en un frame, o nil fuera de los frames activos
+func (ad *AttackDefinition) HitboxAt(frame int, origin utils.Vector2, facingRight bool) *utils.Rectangle {
+	if ad.PhaseAt(frame) != AttackPhaseActive || len(ad.Hitboxes) == 0 {
+		return nil
+	}
+
+	index := frame - ad.Startup
+	if index >= len(ad.Hitboxes) {
+		index = len(ad.Hitboxes) - 1
+	}
+
+	rect := ad.Hitboxes[index].Resolve(origin, facingRight)
+	return &rect
+}
+
+// CanCancelAt retorna true si el frame está dentro de una ventana de cancelación
+func (ad *AttackDefinition) CanCancelAt(frame int) bool {
+	for _, window := range ad.CancelWindows {
+		if frame >= window.Start && frame <= window.End {
+			return true
+		}
+	}
+	return false
+}
+
+// ============================================================================
+// ATAQUE EN CURSO
+// ============================================================================
+
+// AttackState es el ataque que una entidad está ejecutando.
+// Se actualiza en el hilo del juego junto con la entidad (no es thread-safe).
+type AttackState struct {
+	Def   *AttackDefinition
+	Frame int
+	ID    uint64 // Instancia del ataque (un golpe por objetivo)
+}
+
+// Start inicia un ataque con una instancia nueva
+func (as *AttackState) Start(def *AttackDefinition) {
+	as.Def = def
+	as.Frame = 0
+	as.ID = NewAttackID()
+}
+
+// Advance avanza un frame. Retorna true si el ataque terminó en este frame.
+func (as *AttackState) Advance() bool {
+	if as.Def == nil {
+		return false
+	}
+
+	as.Frame++
+	if as.Frame >= as.Def.TotalFrames() {
+		as.Stop()
+		return true
+	}
+	return false
+}
+
+// Stop cancela el ataque
+func (as *AttackState) Stop() {
+	as.Def = nil
+	as.Frame = 0
+}
+
+// IsRunning retorna true si hay un ataque en curso
+func (as *AttackState) IsRunning() bool {
+	return as.Def != nil
+}
+
+// Is retorna true si el ataque en curso es def
+func (as *AttackState) Is(def *AttackDefinition) bool {
+	return as.Def != nil && as.Def == def
+}
+
+// Phase retorna la fase del ataque en curso
+func (as *AttackState) Phase() AttackPhase {
+	if as.Def == nil {
+		return AttackPhaseNone
+	}
+	return as.Def.PhaseAt(as.Frame)
+}
+
+// IsFirstActiveFrame retorna true en el primer frame con hitbox (momento del impacto)
+func (as *AttackState) IsFirstActiveFrame() bool {
+	return as.Def != nil && as.Def.Active > 0 && as.Frame == as.Def.Startup
+}
+
+// Hitbox retorna el hitbox del frame actual, o nil si no está activo
+func (as *AttackState) Hitbox(origin utils.Vector2, facingRight bool) *utils.Rectangle {
+	if as.Def == nil {
+		return nil
+	}
+	return as.Def.HitboxAt(as.Frame, origin, facingRight)
+}
+
+// CanCancel retorna true si el frame actual permite cancelar el ataque
+func (as *AttackState) CanCancel() bool {
+	return as.Def != nil && as.Def.CanCancelAt(as.Frame)
+}
@@ -0,0 +1,58 @@
+package combat
+
+import "sync"
+
+// CombatLog guarda las últimas líneas de combate (THREAD-SAFE)
+// Se llena desde los listeners de eventos y se lee al dibujar.
+type CombatLog struct {
+	entries  []string
+	next     int
+	count    int
+	capacity int
+	mu       sync.Mutex
+}
+
+// NewCombatLog crea un log con capacidad fija (buffer circular)
+func NewCombatLog(capacity int) *CombatLog {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &CombatLog{
+		entries:  make([]string, capacity),
+		capacity: capacity,
+	}
+}
+
+// Add agrega una línea, descartando la más vieja si está lleno (THREAD-SAFE)
+func (cl *CombatLog) Add(entry string) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	cl.entries[cl.next] = entry
+	cl.next = (cl.next + 1) % cl.capacity
+	if cl.count < cl.capacity {
+		cl.count++
+	}
+}
+
+// Entries retorna una COPIA de las líneas, de la más vieja a la más nueva (THREAD-SAFE)
+func (cl *CombatLog) Entries() []string {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	entries := make([]string, 0, cl.count)
+	start := (cl.next - cl.count + cl.capacity) % cl.capacity
+	for i := 0; i < cl.count; i++ {
+		entries = append(entries, cl.entries[(start+i)%cl.capacity])
+	}
+	return entries
+}
+
+// Clear elimina todas las líneas (THREAD-SAFE)
+func (cl *CombatLog) Clear() {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	cl.next = 0
+	cl.count = 0
+}
@@ -1,6 +1,7 @@
 package combat
 
 import (
+	"fmt"
 	"math/rand"
 	"time"
 )
@@ -10,10 +11,61 @@ type DamageType int
 
 const (
 	DamagePhysical DamageType = iota
+	DamageFire                // Fuego (puede aplicar quemadura)
 	DamageMagic
 	DamageTrue // Daño verdadero (ignora defensa)
 )
 
+// String retorna el nombre del tipo de daño
+func (dt DamageType) String() string {
+	switch dt {
+	case DamagePhysical:
+		return "Físico"
+	case DamageFire:
+		return "Fuego"
+	case DamageMagic:
+		return "Mágico"
+	case DamageTrue:
+		return "Verdadero"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText exporta el tipo de daño por nombre (JSON)
+func (dt DamageType) MarshalText() ([]byte, error) {
+	return []byte(dt.String()), nil
+}
+
+// DamageBreakdown detalla cada paso del cálculo de daño (para debug y combat log)
+type DamageBreakdown struct {
+	Type            DamageType
+	Base            int
+	ComboMultiplier float64
+	IsCritical      bool
+	Variance        float64
+	Raw             int     // Tras combo, crítico y variación
+	Resistance      float64 // Fracción resistida (negativa = debilidad)
+	Defense         int     // Defensa plana restada
+	Vulnerability   float64 // Daño extra por vulnerabilidad (0.5 = +50%)
+	Final           int
+}
+
+// String retorna el desglose en una línea
+func (db DamageBreakdown) String() string {
+	crit := ""
+	if db.IsCritical {
+		crit = " CRIT"
+	}
+	vuln := ""
+	if db.Vulnerability != 0 {
+		vuln = fmt.Sprintf(" | vuln %+.0f%%", db.Vulnerability*100)
+	}
+	return fmt.Sprintf("%s %d x%.2f%s ~%.2f = %d | res %+.0f%% | def -%d%s => %d",
+		db.Type, db.Base, db.ComboMultiplier, crit, db.Variance,
+		db.Raw, db.Resistance*100, db.Defense, vuln, db.Final)
+}
+
 // DamageCalculator calcula el daño con modificadores
 type DamageCalculator struct {
 	rng *rand.Rand
@@ -26,13 +78,32 @@ func NewDamageCalculator() *DamageCalculator {
 	}
 }
 
-// CalculateDamage calcula el daño final con todos los modificadores
+// CalculateDamage calcula el daño final con todos los modificadores (sin defensas)
 func (dc *DamageCalculator) CalculateDamage(
 	baseDamage int,
 	damageType DamageType,
 	isCritical bool,
 	comboMultiplier float64,
 ) int {
+	return dc.CalculateDamageBreakdown(baseDamage, damageType, isCritical, comboMultiplier, Defenses{}).Final
+}
+
+// CalculateDamageBreakdown calcula el daño contra las defensas del objetivo
+// y retorna el desglose de cada paso
+func (dc *DamageCalculator) CalculateDamageBreakdown(
+	baseDamage int,
+	damageType DamageType,
+	isCritical bool,
+	comboMultiplier float64,
+	defenses Defenses,
+) DamageBreakdown {
+	breakdown := DamageBreakdown{
+		Type:            damageType,
+		Base:            baseDamage,
+		ComboMultiplier: comboMultiplier,
+		IsCritical:      isCritical,
+	}
+
 	damage := float64(baseDamage)
 
 	// Aplicar multiplicador de combo
@@ -44,15 +115,31 @@ func (dc *DamageCalculator) CalculateDamage(
 	}
 
 	// Variación aleatoria ±10%
-	variance := 0.9 + dc.rng.Float64()*0.2
-	damage *= variance
+	breakdown.Variance = 0.9 + dc.rng.Float64()*0.2
+	damage *= breakdown.Variance
+	breakdown.Raw = int(damage)
+
+	// Resistencia por tipo y defensa plana (el daño verdadero las ignora)
+	if damageType != DamageTrue {
+		breakdown.Resistance = defenses.Resistance(damageType)
+		damage *= 1.0 - breakdown.Resistance
+
+		breakdown.Defense = defenses.Defense
+		damage -= float64(defenses.Defense)
+	}
+
+	// Vulnerabilidad (también afecta al daño verdadero)
+	if defenses.Vulnerability != 0 {
+		breakdown.Vulnerability = defenses.Vulnerability
+		damage *= 1.0 + defenses.Vulnerability
+	}
 
-	finalDamage := int(damage)
-	if finalDamage < 1 {
-		finalDamage = 1
+	breakdown.Final = int(damage)
+	if breakdown.Final < 1 {
+		breakdown.Final = 1
 	}
 
-	return finalDamage
+	return breakdown
 }
 
 // RollCritical determina si un ataque es crítico
@@ -2,6 +2,7 @@ package combat
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
@@ -21,36 +22,164 @@ const (
 	EventBlock
 	EventParry
 	EventDodge
+	EventStatusApplied
+	EventStatusExpired
+	EventFightStarted // Inicio de una pelea (abre un archivo del event log)
+	EventFightEnded   // Fin de una pelea (Metadata["result"]: "victory" / "defeat")
+	EventStagger      // Postura rota: el objetivo queda aturdido
+	EventFlinch       // Movimiento interrumpido por un golpe
+	EventArmorHit     // La armadura del movimiento absorbió el golpe
 )
 
+// eventTypeNames son los nombres de los eventos (logs y exportación)
+var eventTypeNames = [...]string{
+	EventDamageDealt:    "damage_dealt",
+	EventDamageTaken:    "damage_taken",
+	EventAttackLanded:   "attack_landed",
+	EventAttackMissed:   "attack_missed",
+	EventKill:           "kill",
+	EventComboIncreased: "combo_increased",
+	EventCriticalHit:    "critical_hit",
+	EventBlock:          "block",
+	EventParry:          "parry",
+	EventDodge:          "dodge",
+	EventStatusApplied:  "status_applied",
+	EventStatusExpired:  "status_expired",
+	EventFightStarted:   "fight_started",
+	EventFightEnded:     "fight_ended",
+	EventStagger:        "stagger",
+	EventFlinch:         "flinch",
+	EventArmorHit:       "armor_hit",
+}
+
+// String retorna el nombre del evento
+func (et EventType) String() string {
+	if et < 0 || int(et) >= len(eventTypeNames) {
+		return "unknown"
+	}
+	return eventTypeNames[et]
+}
+
+// MarshalText exporta el evento por nombre (JSON)
+func (et EventType) MarshalText() ([]byte, error) {
+	return []byte(et.String()), nil
+}
+
+// AllEventTypes retorna todos los tipos de evento (para listeners globales)
+func AllEventTypes() []EventType {
+	types := make([]EventType, len(eventTypeNames))
+	for i := range types {
+		types[i] = EventType(i)
+	}
+	return types
+}
+
 // CombatEvent representa un evento de combate
 type CombatEvent struct {
 	Type       EventType
+	Frame      uint64 // Frame del juego en que se emitió
 	Timestamp  time.Time
 	Damage     int
 	Position   utils.Vector2
-	Attacker   string // "player" o "boss"
-	Target     string // "player" o "boss"
+	Attacker   ActorID // NoActor si no hay atacante (ej. quemadura)
+	Target     ActorID
 	IsCritical bool
 	ComboCount int
 	Metadata   map[string]interface{} // Datos adicionales
 }
 
-// EventSystem maneja los eventos de combate usando channels
+// OverflowPolicy define qué hacer cuando el channel de eventos está lleno
+type OverflowPolicy int
+
+const (
+	OverflowDropNewest OverflowPolicy = iota // Descarta el evento nuevo
+	OverflowDropOldest                       // Descarta el evento más viejo del channel
+	OverflowBlock                            // Espera hasta BlockTimeout y luego descarta
+	OverflowGrow                             // Guarda el exceso en un buffer extra (hasta MaxOverflow; luego descarta el más viejo)
+)
+
+// String retorna el nombre de la política
+func (op OverflowPolicy) String() string {
+	switch op {
+	case OverflowDropNewest:
+		return "DropNewest"
+	case OverflowDropOldest:
+		return "DropOldest"
+	case OverflowBlock:
+		return "Block"
+	case OverflowGrow:
+		return "Grow"
+	default:
+		return "Unknown"
+	}
+}
+
+// EventSystemConfig contiene la configuración del sistema de eventos
+type EventSystemConfig struct {
+	BufferSize   int
+	Policy       OverflowPolicy
+	BlockTimeout time.Duration // Solo OverflowBlock
+	MaxOverflow  int           // Solo OverflowGrow: tope del buffer extra (0 = 10 veces BufferSize)
+}
+
+// DefaultEventSystemConfig retorna la configuración por defecto
+func DefaultEventSystemConfig() EventSystemConfig {
+	return EventSystemConfig{
+		BufferSize:   100,
+		Policy:       OverflowDropNewest,
+		BlockTimeout: 2 * time.Millisecond,
+		MaxOverflow:  1000,
+	}
+}
+
+// dropOldestAttempts es cuántas veces se intenta hacer hueco con OverflowDropOldest
+const dropOldestAttempts = 3
+
+// EventSystem maneja los eventos de combate usando channels.
+//
+// La goroutine del sistema recibe los eventos, actualiza las estadísticas y los
+// encola. Los listeners normales corren en el hilo del juego cuando se llama a
+// Dispatch (un punto fijo del Update). Los listeners async corren en la goroutine
+// y DEBEN ser thread-safe.
 type EventSystem struct {
+	config EventSystemConfig
+
 	// Channels para eventos
-	eventChannel chan CombatEvent
-	doneChannel  chan bool
+	eventChannel   chan CombatEvent
+	doneChannel    chan struct{}
+	stoppedChannel chan struct{}
+
+	// Buffer extra (OverflowGrow): mientras tenga eventos, los nuevos van aquí
+	// para conservar el orden
+	overflow       []CombatEvent
+	overflowMu     sync.Mutex
+	overflowSignal chan struct{}
 
 	// Listeners (funciones que reaccionan a eventos)
-	listeners map[EventType][]func(CombatEvent)
+	listeners      map[EventType][]func(CombatEvent) // Hilo del juego (Dispatch)
+	asyncListeners map[EventType][]func(CombatEvent) // Goroutine del sistema
+	listenersMu    sync.RWMutex
+
+	// Cola de eventos pendientes para el hilo del juego
+	pending   []CombatEvent
+	dispatch  []CombatEvent // Buffer reutilizado por Dispatch
+	pendingMu sync.Mutex
+
+	// Copia de los listeners de un evento (solo la usa Dispatch, en el hilo del juego)
+	dispatchListeners []func(CombatEvent)
 
 	// Estadísticas
 	stats   *CombatStats
 	statsMu sync.Mutex
 
-	// Control
-	isRunning bool
+	// Frame actual del juego (se estampa en cada evento)
+	frame atomic.Uint64
+
+	// Control: los emisores toman RLock, Stop toma Lock
+	// (así nunca se envía mientras se detiene el sistema)
+	isRunning   bool
+	isStopped   bool
+	lifecycleMu sync.RWMutex
 }
 
 // CombatStats guarda estadísticas de combate
@@ -59,6 +188,8 @@ type CombatStats struct {
 	PlayerDamageTaken   int
 	BossDamageDealt     int
 	BossDamageTaken     int
+	MinionDamageDealt   int // Los minions comparten facción con el boss: van aparte
+	MinionDamageTaken   int
 	PlayerAttacksLanded int
 	PlayerAttacksMissed int
 	BossAttacksLanded   int
@@ -66,73 +197,279 @@ type CombatStats struct {
 	HighestCombo        int
 	TotalHits           int
 	CriticalHits        int
+	PlayerBlocks        int
+	BossBlocks          int
+	PlayerParries       int
+	PlayerDodges        int // Todas las esquivas con i-frames
+	PlayerPerfectDodges int // Las que además fueron perfectas
+	BossStaggers        int // Veces que el jugador rompió la postura del boss
+	BossFlinches        int // Movimientos del boss interrumpidos
+	MinionsKilled       int
 	TotalEvents         int
+
+	// Backpressure
+	DroppedEvents    int // Eventos perdidos (channel lleno o sistema detenido)
+	OverflowedEvents int // Eventos que pasaron por el buffer extra (OverflowGrow)
 }
 
-// NewEventSystem crea un nuevo sistema de eventos
+// NewEventSystem crea un nuevo sistema de eventos con la política por defecto
 func NewEventSystem(bufferSize int) *EventSystem {
+	cfg := DefaultEventSystemConfig()
+	cfg.BufferSize = bufferSize
+	return NewEventSystemWithConfig(cfg)
+}
+
+// NewEventSystemWithConfig crea un nuevo sistema de eventos con configuración
+func NewEventSystemWithConfig(cfg EventSystemConfig) *EventSystem {
+	if cfg.BufferSize < 1 {
+		cfg.BufferSize = 1
+	}
+	if cfg.MaxOverflow < 1 {
+		cfg.MaxOverflow = cfg.BufferSize * 10
+	}
+
 	return &EventSystem{
-		eventChannel: make(chan CombatEvent, bufferSize),
-		doneChannel:  make(chan bool),
-		listeners:    make(map[EventType][]func(CombatEvent)),
-		stats:        &CombatStats{},
-		isRunning:    false,
+		config:         cfg,
+		eventChannel:   make(chan CombatEvent, cfg.BufferSize),
+		overflowSignal: make(chan struct{}, 1),
+		listeners:      make(map[EventType][]func(CombatEvent)),
+		asyncListeners: make(map[EventType][]func(CombatEvent)),
+		pending:        make([]CombatEvent, 0, cfg.BufferSize),
+		dispatch:       make([]CombatEvent, 0, cfg.BufferSize),
+		stats:          &CombatStats{},
+		isRunning:      false,
 	}
 }
 
 // Start inicia el sistema de eventos (goroutine)
 func (es *EventSystem) Start() {
+	es.lifecycleMu.Lock()
+	defer es.lifecycleMu.Unlock()
+
 	if es.isRunning {
 		return
 	}
 	es.isRunning = true
-	go func() {
-		for {
+	es.isStopped = false
+	es.doneChannel = make(chan struct{})
+	es.stoppedChannel = make(chan struct{})
+
+	go es.run(es.doneChannel, es.stoppedChannel)
+}
+
+// run es el loop de la goroutine del sistema
+func (es *EventSystem) run(done, stopped chan struct{}) {
+	defer close(stopped)
+
+	for {
+		select {
+		case event := <-es.eventChannel:
+			// Procesar evento
+			es.processEvent(event)
+
+		case <-es.overflowSignal:
+			// Hay eventos en el buffer extra
+			es.drain()
+
+		case <-done:
+			// Procesar lo pendiente y terminar goroutine
+			es.drain()
+			return
+		}
+	}
+}
+
+// drain procesa todo lo que haya en el channel y en el buffer extra, en orden
+func (es *EventSystem) drain() {
+	for {
+		// Primero el channel (son eventos más viejos que los del buffer extra)
+		for drained := false; !drained; {
 			select {
 			case event := <-es.eventChannel:
-				// Procesar evento
 				es.processEvent(event)
-
-			case <-es.doneChannel:
-				// Terminar goroutine
-				return
+			default:
+				drained = true
 			}
 		}
-	}()
+
+		es.overflowMu.Lock()
+		batch := es.overflow
+		es.overflow = nil
+		es.overflowMu.Unlock()
+
+		if len(batch) == 0 {
+			return
+		}
+		for _, event := range batch {
+			es.processEvent(event)
+		}
+	}
 }
 
-// Stop detiene el sistema de eventos
+// Stop detiene el sistema de eventos (THREAD-SAFE).
+// Espera a que la goroutine procese los eventos pendientes. Los eventos que
+// se emitan después se descartan y cuentan como perdidos.
 func (es *EventSystem) Stop() {
+	es.lifecycleMu.Lock()
 	if !es.isRunning {
+		es.lifecycleMu.Unlock()
 		return
 	}
-
 	es.isRunning = false
-	es.doneChannel <- true
-	close(es.eventChannel)
+	es.isStopped = true
+	done, stopped := es.doneChannel, es.stoppedChannel
+	es.lifecycleMu.Unlock()
+
+	// Ningún emisor está enviando ahora (tendría el RLock): drenar y esperar
+	close(done)
+	<-stopped
 }
 
-// EmitEvent envía un evento al sistema (non-blocking)
+// SetFrame actualiza el frame que se estampa en los eventos (THREAD-SAFE)
+func (es *EventSystem) SetFrame(frame uint64) {
+	es.frame.Store(frame)
+}
+
+// EmitEvent envía un evento al sistema según la política de overflow (THREAD-SAFE)
 func (es *EventSystem) EmitEvent(event CombatEvent) {
 	event.Timestamp = time.Now()
+	event.Frame = es.frame.Load()
 
-	// Enviar al channel de forma no bloqueante
-	select {
-	case es.eventChannel <- event:
-		// Evento enviado exitosamente
-	default:
-		// Channel lleno, ignorar evento (evita bloqueos)
+	es.lifecycleMu.RLock()
+	defer es.lifecycleMu.RUnlock()
+
+	if es.isStopped {
+		es.countDropped()
+		return
+	}
+
+	switch es.config.Policy {
+	case OverflowDropOldest:
+		for attempt := 0; attempt < dropOldestAttempts; attempt++ {
+			select {
+			case es.eventChannel <- event:
+				return
+			default:
+			}
+
+			// Hacer hueco descartando el más viejo
+			select {
+			case <-es.eventChannel:
+				es.countDropped()
+			default:
+			}
+		}
+		es.countDropped()
+
+	case OverflowBlock:
+		select {
+		case es.eventChannel <- event:
+			return
+		default:
+		}
+
+		timer := time.NewTimer(es.config.BlockTimeout)
+		defer timer.Stop()
+
+		select {
+		case es.eventChannel <- event:
+		case <-timer.C:
+			es.countDropped()
+		}
+
+	case OverflowGrow:
+		es.overflowMu.Lock()
+		if len(es.overflow) == 0 {
+			select {
+			case es.eventChannel <- event:
+				es.overflowMu.Unlock()
+				return
+			default:
+			}
+		}
+		// Buffer extra lleno (la goroutine no da abasto): se pierde el más viejo
+		dropped := len(es.overflow) >= es.config.MaxOverflow
+		if dropped {
+			es.overflow = append(es.overflow[1:], event)
+		} else {
+			es.overflow = append(es.overflow, event)
+		}
+		es.overflowMu.Unlock()
+
+		if dropped {
+			es.countDropped()
+		}
+
+		es.statsMu.Lock()
+		es.stats.OverflowedEvents++
+		es.statsMu.Unlock()
+
+		// Despertar a la goroutine (sin bloquear si ya hay una señal)
+		select {
+		case es.overflowSignal <- struct{}{}:
+		default:
+		}
+
+	default: // OverflowDropNewest
+		select {
+		case es.eventChannel <- event:
+		default:
+			es.countDropped()
+		}
 	}
 }
 
+// countDropped suma un evento perdido a las estadísticas (THREAD-SAFE)
+func (es *EventSystem) countDropped() {
+	es.statsMu.Lock()
+	defer es.statsMu.Unlock()
+
+	es.stats.DroppedEvents++
+}
+
 // processEvent procesa un evento (llamado por la goroutine)
 func (es *EventSystem) processEvent(event CombatEvent) {
 	// Actualizar estadísticas
 	es.updateStats(event)
 
-	// Notificar a los listeners
-	if listeners, exists := es.listeners[event.Type]; exists {
-		for _, listener := range listeners {
+	// Notificar a los listeners async (en esta goroutine)
+	es.listenersMu.RLock()
+	asyncListeners := es.asyncListeners[event.Type]
+	hasListeners := len(es.listeners[event.Type]) > 0
+	es.listenersMu.RUnlock()
+
+	for _, listener := range asyncListeners {
+		listener(event)
+	}
+
+	// Encolar para el hilo del juego
+	if hasListeners {
+		es.pendingMu.Lock()
+		es.pending = append(es.pending, event)
+		es.pendingMu.Unlock()
+	}
+}
+
+// Dispatch ejecuta los listeners normales con los eventos encolados.
+// Llamar UNA vez por frame desde el hilo del juego.
+func (es *EventSystem) Dispatch() {
+	// Intercambiar buffers para no bloquear a la goroutine mientras corren los listeners
+	es.pendingMu.Lock()
+	es.pending, es.dispatch = es.dispatch[:0], es.pending
+	es.pendingMu.Unlock()
+
+	if len(es.dispatch) == 0 {
+		return
+	}
+
+	for _, event := range es.dispatch {
+		// Copiar los listeners y soltar el lock antes de llamarlos: un listener
+		// puede registrar otros (AddListener toma el lock de escritura)
+		es.listenersMu.RLock()
+		es.dispatchListeners = append(es.dispatchListeners[:0], es.listeners[event.Type]...)
+		es.listenersMu.RUnlock()
+
+		for _, listener := range es.dispatchListeners {
 			listener(event)
 		}
 	}
@@ -147,29 +484,35 @@ func (es *EventSystem) updateStats(event CombatEvent) {
 
 	switch event.Type {
 	case EventDamageDealt:
-		if event.Attacker == "player" {
+		switch {
+		case event.Attacker.Faction == FactionPlayer:
 			es.stats.PlayerDamageDealt += event.Damage
-		} else {
+		case event.Attacker.Kind == ActorKindMinion:
+			es.stats.MinionDamageDealt += event.Damage
+		default:
 			es.stats.BossDamageDealt += event.Damage
 		}
 
 	case EventDamageTaken:
-		if event.Target == "player" {
+		switch {
+		case event.Target.Faction == FactionPlayer:
 			es.stats.PlayerDamageTaken += event.Damage
-		} else {
+		case event.Target.Kind == ActorKindMinion:
+			es.stats.MinionDamageTaken += event.Damage
+		default:
 			es.stats.BossDamageTaken += event.Damage
 		}
 
 	case EventAttackLanded:
 		es.stats.TotalHits++
-		if event.Attacker == "player" {
+		if event.Attacker.Faction == FactionPlayer {
 			es.stats.PlayerAttacksLanded++
 		} else {
 			es.stats.BossAttacksLanded++
 		}
 
 	case EventAttackMissed:
-		if event.Attacker == "player" {
+		if event.Attacker.Faction == FactionPlayer {
 			es.stats.PlayerAttacksMissed++
 		} else {
 			es.stats.BossAttacksMissed++
@@ -182,14 +525,61 @@ func (es *EventSystem) updateStats(event CombatEvent) {
 
 	case EventCriticalHit:
 		es.stats.CriticalHits++
+
+	case EventBlock:
+		if event.Target.Faction == FactionPlayer {
+			es.stats.PlayerBlocks++
+		} else {
+			es.stats.BossBlocks++
+		}
+
+	case EventParry:
+		if event.Target.Faction == FactionPlayer {
+			es.stats.PlayerParries++
+		}
+
+	case EventDodge:
+		if event.Target.Faction == FactionPlayer {
+			es.stats.PlayerDodges++
+			if perfect, _ := event.Metadata["perfect"].(bool); perfect {
+				es.stats.PlayerPerfectDodges++
+			}
+		}
+
+	case EventStagger:
+		if event.Target.Faction != FactionPlayer {
+			es.stats.BossStaggers++
+		}
+
+	case EventFlinch:
+		if event.Target.Faction != FactionPlayer {
+			es.stats.BossFlinches++
+		}
+
+	case EventKill:
+		if event.Target.Kind == ActorKindMinion {
+			es.stats.MinionsKilled++
+		}
 	}
 }
 
-// AddListener añade un listener para un tipo de evento
+// AddListener añade un listener que corre en el hilo del juego (durante Dispatch)
 func (es *EventSystem) AddListener(eventType EventType, listener func(CombatEvent)) {
+	es.listenersMu.Lock()
+	defer es.listenersMu.Unlock()
+
 	es.listeners[eventType] = append(es.listeners[eventType], listener)
 }
 
+// AddAsyncListener añade un listener que corre en la goroutine del sistema.
+// El listener DEBE ser thread-safe (no tocar estado del juego sin locks).
+func (es *EventSystem) AddAsyncListener(eventType EventType, listener func(CombatEvent)) {
+	es.listenersMu.Lock()
+	defer es.listenersMu.Unlock()
+
+	es.asyncListeners[eventType] = append(es.asyncListeners[eventType], listener)
+}
+
 // GetStats retorna una COPIA de las estadísticas actuales (THREAD-SAFE)
 func (es *EventSystem) GetStats() CombatStats {
 	es.statsMu.Lock()
@@ -0,0 +1,270 @@
+package combat
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+)
+
+// ============================================================================
+// REGISTRO DE EVENTOS EN JSON LINES
+// ============================================================================
+
+// EventLogRecord es una línea del archivo JSONL
+type EventLogRecord struct {
+	Fight     int                    `json:"fight"`
+	Frame     uint64                 `json:"frame"`
+	Timestamp time.Time              `json:"timestamp"`
+	Type      EventType              `json:"type"`
+	Attacker  ActorID                `json:"attacker"`
+	Target    ActorID                `json:"target"`
+	Damage    int                    `json:"damage"`
+	Critical  bool                   `json:"critical"`
+	Combo     int                    `json:"combo"`
+	Position  utils.Vector2          `json:"position"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// EventLoggerConfig contiene la configuración del logger
+type EventLoggerConfig struct {
+	Dir           string        // Carpeta de los archivos
+	BufferSize    int           // Eventos en cola antes de descartar
+	FlushInterval time.Duration // Cada cuánto se vacía el buffer a disco
+}
+
+// DefaultEventLoggerConfig retorna la configuración por defecto
+func DefaultEventLoggerConfig() EventLoggerConfig {
+	return EventLoggerConfig{
+		Dir:           "logs",
+		BufferSize:    512,
+		FlushInterval: time.Second,
+	}
+}
+
+// EventLogger escribe los eventos de combate en un archivo JSONL por pelea.
+//
+// Cada EventFightStarted abre un archivo nuevo y cada EventFightEnded lo cierra.
+// Como los límites de la pelea viajan por la misma cola que el resto de eventos,
+// ningún evento termina en el archivo equivocado. La escritura ocurre en una
+// goroutine propia con buffer y flush periódico.
+type EventLogger struct {
+	config  EventLoggerConfig
+	session string // Prefijo de los archivos de esta sesión
+
+	events  chan CombatEvent
+	done    chan struct{}
+	stopped chan struct{}
+
+	dropped   int
+	droppedMu sync.Mutex
+
+	// Log toma RLock, Close toma Lock (nunca se envía tras cerrar)
+	isClosed    bool
+	lifecycleMu sync.RWMutex
+}
+
+// NewEventLogger crea el logger e inicia su goroutine de escritura
+func NewEventLogger(cfg EventLoggerConfig) *EventLogger {
+	if cfg.BufferSize < 1 {
+		cfg.BufferSize = 1
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = time.Second
+	}
+
+	el := &EventLogger{
+		config:  cfg,
+		session: time.Now().Format("20060102_150405"),
+		events:  make(chan CombatEvent, cfg.BufferSize),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+
+	go el.run()
+	return el
+}
+
+// Attach registra el logger como listener async de todos los eventos
+func (el *EventLogger) Attach(es *EventSystem) {
+	for _, eventType := range AllEventTypes() {
+		es.AddAsyncListener(eventType, el.Log)
+	}
+}
+
+// Log encola un evento para escribirlo (THREAD-SAFE).
+// Los eventos normales se descartan si la cola está llena; los límites de
+// la pelea esperan (perderlos mezclaría dos peleas en un archivo).
+func (el *EventLogger) Log(event CombatEvent) {
+	el.lifecycleMu.RLock()
+	defer el.lifecycleMu.RUnlock()
+
+	if el.isClosed {
+		return
+	}
+
+	if event.Type == EventFightStarted || event.Type == EventFightEnded {
+		el.events <- event
+		return
+	}
+
+	select {
+	case el.events <- event:
+	default:
+		el.droppedMu.Lock()
+		el.dropped++
+		el.droppedMu.Unlock()
+	}
+}
+
+// Dropped retorna cuántos eventos se descartaron por cola llena (THREAD-SAFE)
+func (el *EventLogger) Dropped() int {
+	el.droppedMu.Lock()
+	defer el.droppedMu.Unlock()
+
+	return el.dropped
+}
+
+// Close escribe lo pendiente, cierra el archivo y detiene la goroutine (THREAD-SAFE).
+// Llamar después de detener el EventSystem para no perder sus últimos eventos.
+func (el *EventLogger) Close() {
+	el.lifecycleMu.Lock()
+	if el.isClosed {
+		el.lifecycleMu.Unlock()
+		return
+	}
+	el.isClosed = true
+	el.lifecycleMu.Unlock()
+
+	close(el.done)
+	<-el.stopped
+}
+
+// run es el loop de la goroutine de escritura
+func (el *EventLogger) run() {
+	defer close(el.stopped)
+
+	w := &fightWriter{dir: el.config.Dir, session: el.session}
+	defer w.close()
+
+	ticker := time.NewTicker(el.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event := <-el.events:
+			w.write(event)
+
+		case <-ticker.C:
+			w.flush()
+
+		case <-el.done:
+			// Escribir lo que quede en la cola
+			for {
+				select {
+				case event := <-el.events:
+					w.write(event)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// ============================================================================
+// ARCHIVO DE LA PELEA (solo lo usa la goroutine del logger)
+// ============================================================================
+
+// fightWriter mantiene abierto el archivo de la pelea actual
+type fightWriter struct {
+	dir     string
+	session string
+	fight   int
+
+	file    *os.File
+	writer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+// write escribe un evento, abriendo o cerrando el archivo en los límites de la pelea
+func (fw *fightWriter) write(event CombatEvent) {
+	if event.Type == EventFightStarted {
+		fw.open()
+	}
+	if fw.encoder == nil {
+		return // Fuera de una pelea
+	}
+
+	record := EventLogRecord{
+		Fight:     fw.fight,
+		Frame:     event.Frame,
+		Timestamp: event.Timestamp,
+		Type:      event.Type,
+		Attacker:  event.Attacker,
+		Target:    event.Target,
+		Damage:    event.Damage,
+		Critical:  event.IsCritical,
+		Combo:     event.ComboCount,
+		Position:  event.Position,
+		Metadata:  event.Metadata,
+	}
+	if err := fw.encoder.Encode(record); err != nil {
+		log.Printf("⚠️ Event log: %v", err)
+	}
+
+	if event.Type == EventFightEnded {
+		fw.close()
+	}
+}
+
+// open cierra el archivo anterior (si quedó abierto) y crea el de la siguiente pelea
+func (fw *fightWriter) open() {
+	fw.close()
+	fw.fight++
+
+	if err := os.MkdirAll(fw.dir, 0o755); err != nil {
+		log.Printf("⚠️ Event log: %v", err)
+		return
+	}
+
+	name := fmt.Sprintf("fight_%s_%03d.jsonl", fw.session, fw.fight)
+	file, err := os.Create(filepath.Join(fw.dir, name))
+	if err != nil {
+		log.Printf("⚠️ Event log: %v", err)
+		return
+	}
+
+	fw.file = file
+	fw.writer = bufio.NewWriterSize(file, 64*1024)
+	fw.encoder = json.NewEncoder(fw.writer)
+}
+
+// flush vacía el buffer a disco
+func (fw *fightWriter) flush() {
+	if fw.writer == nil {
+		return
+	}
+	if err := fw.writer.Flush(); err != nil {
+		log.Printf("⚠️ Event log: %v", err)
+	}
+}
+
+// close vacía el buffer y cierra el archivo actual
+func (fw *fightWriter) close() {
+	if fw.file == nil {
+		return
+	}
+
+	fw.flush()
+	if err := fw.file.Close(); err != nil {
+		log.Printf("⚠️ Event log: %v", err)
+	}
+	fw.file, fw.writer, fw.encoder = nil, nil, nil
+}
@@ -0,0 +1,168 @@
+package combat
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Emisores concurrentes + Dispatch en su propio hilo + Stop: con cualquier
+// política, cada evento emitido se procesa o se cuenta como perdido
+// (correr con -race).
+func TestEventSystemConcurrentEmitDispatchStop(t *testing.T) {
+	policies := []OverflowPolicy{OverflowDropNewest, OverflowDropOldest, OverflowBlock, OverflowGrow}
+
+	for _, policy := range policies {
+		t.Run(policy.String(), func(t *testing.T) {
+			const (
+				emitters        = 8
+				eventsPerSender = 500
+				lateEvents      = 10
+			)
+
+			es := NewEventSystemWithConfig(EventSystemConfig{
+				BufferSize:   16, // Pequeño a propósito: fuerza el overflow
+				Policy:       policy,
+				BlockTimeout: time.Millisecond,
+			})
+
+			var dispatched, async atomic.Int64
+			es.AddListener(EventDamageDealt, func(CombatEvent) { dispatched.Add(1) })
+			es.AddAsyncListener(EventDamageDealt, func(CombatEvent) { async.Add(1) })
+			es.Start()
+
+			// El "hilo del juego": despacha hasta que se detenga el sistema
+			stopDispatch := make(chan struct{})
+			dispatchDone := make(chan struct{})
+			go func() {
+				defer close(dispatchDone)
+				for {
+					select {
+					case <-stopDispatch:
+						return
+					default:
+						es.Dispatch()
+					}
+				}
+			}()
+
+			var wg sync.WaitGroup
+			for i := 0; i < emitters; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < eventsPerSender; j++ {
+						es.EmitEvent(CombatEvent{Type: EventDamageDealt, Damage: 1, Attacker: PlayerActor, Target: BossActor})
+					}
+				}()
+			}
+
+			// Detener mientras todavía se emite: lo que llega tarde se pierde, no se cuelga
+			time.Sleep(time.Millisecond)
+			es.Stop()
+			wg.Wait()
+			for j := 0; j < lateEvents; j++ {
+				es.EmitEvent(CombatEvent{Type: EventDamageDealt})
+			}
+
+			close(stopDispatch)
+			<-dispatchDone
+			es.Dispatch() // Lo que quedó encolado tras el último Dispatch
+
+			stats := es.GetStats()
+			emitted := int64(emitters*eventsPerSender + lateEvents)
+			processed := int64(stats.TotalEvents)
+
+			if processed+int64(stats.DroppedEvents) != emitted {
+				t.Fatalf("procesados %d + perdidos %d != emitidos %d", processed, stats.DroppedEvents, emitted)
+			}
+			if dispatched.Load() != processed {
+				t.Errorf("Dispatch entregó %d eventos, se procesaron %d", dispatched.Load(), processed)
+			}
+			if async.Load() != processed {
+				t.Errorf("los listeners async vieron %d eventos, se procesaron %d", async.Load(), processed)
+			}
+			if stats.DroppedEvents < lateEvents {
+				t.Errorf("los %d eventos emitidos tras Stop deberían contar como perdidos (perdidos: %d)", lateEvents, stats.DroppedEvents)
+			}
+		})
+	}
+}
+
+// Un listener que registra otro listener no debe bloquear Dispatch
+func TestEventSystemListenerCanAddListener(t *testing.T) {
+	es := NewEventSystem(8)
+
+	var added atomic.Int64
+	es.AddListener(EventAttackLanded, func(CombatEvent) {
+		es.AddListener(EventAttackLanded, func(CombatEvent) { added.Add(1) })
+	})
+	es.Start()
+
+	es.EmitEvent(CombatEvent{Type: EventAttackLanded})
+	es.Stop()
+
+	done := make(chan struct{})
+	go func() {
+		es.Dispatch()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Dispatch se bloqueó con un listener que llama a AddListener")
+	}
+
+	// El listener nuevo no corre para el evento que lo registró
+	if added.Load() != 0 {
+		t.Errorf("el listener nuevo corrió %d veces para el evento en curso", added.Load())
+	}
+}
+
+// Con OverflowGrow el buffer extra tiene tope: al llenarse se pierde el
+// evento más viejo del buffer y se cuenta como perdido
+func TestEventSystemGrowCapDropsOldest(t *testing.T) {
+	const (
+		bufferSize  = 2
+		maxOverflow = 4
+		emitted     = 10
+	)
+
+	es := NewEventSystemWithConfig(EventSystemConfig{
+		BufferSize:  bufferSize,
+		Policy:      OverflowGrow,
+		MaxOverflow: maxOverflow,
+	})
+
+	var seen []int
+	es.AddListener(EventDamageDealt, func(event CombatEvent) { seen = append(seen, event.Damage) })
+
+	// Sin la goroutine corriendo nadie vacía el channel: todo el exceso va al buffer extra
+	for i := 1; i <= emitted; i++ {
+		es.EmitEvent(CombatEvent{Type: EventDamageDealt, Damage: i})
+	}
+	es.Start()
+	es.Stop()
+	es.Dispatch()
+
+	// El channel conserva los primeros; el buffer extra, los últimos maxOverflow
+	want := []int{1, 2, 7, 8, 9, 10}
+	if len(seen) != len(want) {
+		t.Fatalf("se despacharon %v, se esperaba %v", seen, want)
+	}
+	for i := range want {
+		if seen[i] != want[i] {
+			t.Fatalf("se despacharon %v, se esperaba %v", seen, want)
+		}
+	}
+
+	stats := es.GetStats()
+	if stats.DroppedEvents != emitted-len(want) {
+		t.Errorf("perdidos %d, se esperaban %d", stats.DroppedEvents, emitted-len(want))
+	}
+	if stats.TotalEvents != len(want) {
+		t.Errorf("procesados %d, se esperaban %d", stats.TotalEvents, len(want))
+	}
+}
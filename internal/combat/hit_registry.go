@@ -0,0 +1,95 @@
+package combat
+
+import "sync/atomic"
+
+// attackIDCounter genera IDs únicos para cada instancia de ataque
+var attackIDCounter uint64
+
+// NewAttackID retorna un ID nuevo para una instancia de ataque (THREAD-SAFE)
+// El ID 0 está reservado para daño continuo (contacto) que no se registra.
+func NewAttackID() uint64 {
+	return atomic.AddUint64(&attackIDCounter, 1)
+}
+
+// hitKey identifica un golpe de un ataque sobre un objetivo
+type hitKey struct {
+	attackID uint64
+	target   ActorID
+}
+
+// HitRegistry recuerda qué instancias de ataque ya golpearon a cada objetivo,
+// para que un ataque solo conecte una vez por objetivo.
+// Se usa desde el hilo del juego (no es thread-safe).
+type HitRegistry struct {
+	hits   map[hitKey]uint64 // Frame en que se registró el golpe
+	landed map[uint64]uint64 // Ataques que hicieron daño a alguien (frame)
+}
+
+// NewHitRegistry crea un registro de golpes vacío
+func NewHitRegistry() *HitRegistry {
+	return &HitRegistry{
+		hits:   make(map[hitKey]uint64),
+		landed: make(map[uint64]uint64),
+	}
+}
+
+// HasHit retorna true si el ataque ya golpeó al objetivo
+func (hr *HitRegistry) HasHit(attackID uint64, target ActorID) bool {
+	if attackID == 0 {
+		return false
+	}
+	_, exists := hr.hits[hitKey{attackID, target}]
+	return exists
+}
+
+// Register marca que el ataque golpeó al objetivo
+func (hr *HitRegistry) Register(attackID uint64, target ActorID, frame uint64) {
+	if attackID == 0 {
+		return
+	}
+	hr.hits[hitKey{attackID, target}] = frame
+}
+
+// MarkLanded marca que el ataque hizo daño (al menos a un objetivo)
+func (hr *HitRegistry) MarkLanded(attackID uint64, frame uint64) {
+	if attackID == 0 {
+		return
+	}
+	hr.landed[attackID] = frame
+}
+
+// HasLanded retorna true si el ataque hizo daño a algún objetivo
+func (hr *HitRegistry) HasLanded(attackID uint64) bool {
+	_, exists := hr.landed[attackID]
+	return exists
+}
+
+// Forget elimina el registro de daño de un ataque que ya terminó
+func (hr *HitRegistry) Forget(attackID uint64) {
+	delete(hr.landed, attackID)
+}
+
+// Prune elimina registros más antiguos que maxAge frames
+func (hr *HitRegistry) Prune(frame, maxAge uint64) {
+	for key, hitFrame := range hr.hits {
+		if frame-hitFrame > maxAge {
+			delete(hr.hits, key)
+		}
+	}
+	for attackID, landedFrame := range hr.landed {
+		if frame-landedFrame > maxAge {
+			delete(hr.landed, attackID)
+		}
+	}
+}
+
+// Clear elimina todos los registros
+func (hr *HitRegistry) Clear() {
+	hr.hits = make(map[hitKey]uint64)
+	hr.landed = make(map[uint64]uint64)
+}
+
+// Size retorna la cantidad de golpes registrados (para debug)
+func (hr *HitRegistry) Size() int {
+	return len(hr.hits)
+}
@@ -0,0 +1,380 @@
+package combat
+
+import "github.com/MarcosBrindis/boss-arena-go/internal/utils"
+
+// ============================================================================
+// INTERFACES DE OBJETIVOS
+// ============================================================================
+
+// Damageable es cualquier entidad que puede recibir golpes
+type Damageable interface {
+	// GetActorID identifica al objetivo en eventos y en el registro de golpes
+	GetActorID() ActorID
+	GetPosition() utils.Vector2
+	// CanReceiveHit es false durante ventanas de invulnerabilidad
+	CanReceiveHit() bool
+	// ReceiveDamage aplica el daño final y el knockback. Retorna true si se aplicó.
+	ReceiveDamage(damage int, knockback utils.Vector2) bool
+}
+
+// Evader es un objetivo con i-frames que puede atravesar golpes
+type Evader interface {
+	// TryEvade retorna si el golpe se esquivó y si contó como esquiva perfecta
+	TryEvade(perfectEligible bool) (evaded, perfect bool)
+}
+
+// Guarder es un objetivo que puede bloquear o hacer parry
+type Guarder interface {
+	// ResolveGuard retorna el resultado de la guardia y el daño restante
+	ResolveGuard(hit *Hit, damage int) (GuardResult, int)
+}
+
+// Staggerable es un objetivo con postura (poise) que se rompe con golpes
+type Staggerable interface {
+	// ApplyPoiseDamage desgasta la postura. Retorna true si el golpe la rompió.
+	ApplyPoiseDamage(amount float64) bool
+}
+
+// ArmorLevel es la armadura de un movimiento frente a las interrupciones
+type ArmorLevel int
+
+const (
+	ArmorNone  ArmorLevel = iota // Los golpes que interrumpen causan flinch
+	ArmorHyper                   // Aguanta las interrupciones (romper la postura sí lo aturde)
+	ArmorSuper                   // Aguanta todo: ni siquiera se le rompe la postura
+)
+
+// String retorna el nombre de la armadura
+func (al ArmorLevel) String() string {
+	switch al {
+	case ArmorNone:
+		return "none"
+	case ArmorHyper:
+		return "hyper"
+	case ArmorSuper:
+		return "super"
+	default:
+		return "unknown"
+	}
+}
+
+// InterruptResult es lo que hizo un golpe con el movimiento en curso
+type InterruptResult int
+
+const (
+	InterruptNone     InterruptResult = iota // Sin efecto
+	InterruptFlinched                        // Movimiento cortado
+	InterruptArmored                         // La armadura absorbió el golpe
+)
+
+// Interruptible es un objetivo cuyos movimientos se pueden cortar con golpes
+type Interruptible interface {
+	// ResolveInterrupt decide si el golpe corta el movimiento o lo absorbe la armadura
+	ResolveInterrupt(hit *Hit) InterruptResult
+}
+
+// GuardResult representa el resultado de la guardia frente a un golpe
+type GuardResult int
+
+const (
+	GuardNone    GuardResult = iota // Sin guardia (o golpe por la espalda)
+	GuardBlocked                    // Daño reducido, cuesta stamina
+	GuardParried                    // Daño anulado
+	GuardBroken                     // Guardia rota: recibe el daño completo
+)
+
+// ============================================================================
+// GOLPES
+// ============================================================================
+
+// Hit describe un golpe que entra al pipeline
+type Hit struct {
+	AttackID   uint64 // Instancia del ataque (0 = contacto continuo, sin registro)
+	AttackName string // Nombre para metadata ("boss_slam", "player_shot"...)
+	Attacker   ActorID
+
+	BaseDamage      int
+	DamageType      DamageType
+	CritChance      float64
+	ComboCount      int
+	ComboMultiplier float64
+
+	BaseKnockback float64
+	Direction     utils.Vector2 // Dirección del knockback
+	Source        utils.Vector2 // Origen del golpe (para la guardia)
+	Position      utils.Vector2 // Punto de impacto (para eventos y efectos)
+
+	Parryable    bool         // Se le puede hacer parry
+	Contact      bool         // Daño por contacto: no cuenta como esquiva perfecta
+	Ranged       bool         // Proyectil (la guardia del boss solo para golpes cuerpo a cuerpo)
+	GuardBreaker bool         // Rompe la guardia (disparo cargado)
+	PoiseDamage  float64      // Desgaste de postura por punto de daño (0 = 1x)
+	Interrupts   bool         // Corta movimientos sin armadura (pogo, disparo cargado)
+	Inflicts     []StatusType // Efectos que aplica si conecta (ej. quemadura)
+}
+
+// HitResult representa qué pasó con un golpe
+type HitResult int
+
+const (
+	HitIgnored      HitResult = iota // Ya conectó antes o el objetivo es invulnerable
+	HitEvaded                        // Atravesó los i-frames
+	HitPerfectDodge                  // Esquiva perfecta
+	HitParried                       // Parry
+	HitBlocked                       // Bloqueado (puede aplicar daño reducido)
+	HitLanded                        // Daño completo
+)
+
+// HitOutcome es el resultado de procesar un golpe
+type HitOutcome struct {
+	Result     HitResult
+	Damage     int
+	IsCritical bool
+	Knockback  utils.Vector2
+	Breakdown  DamageBreakdown
+}
+
+// Connected retorna true si el golpe aplicó daño
+func (o HitOutcome) Connected() bool {
+	return (o.Result == HitLanded || o.Result == HitBlocked) && o.Damage > 0
+}
+
+// ============================================================================
+// PIPELINE
+// ============================================================================
+
+// hitRegistryMaxAge es cuánto se recuerda un golpe (frames)
+const hitRegistryMaxAge = 600
+
+// DamagePipeline procesa todos los golpes del juego:
+// registro → invulnerabilidad → esquiva → cálculo (defensas) → guardia → daño → efectos → postura → interrupción → eventos.
+// Se usa desde el hilo del juego.
+type DamagePipeline struct {
+	calc     *DamageCalculator
+	registry *HitRegistry
+	events   *EventSystem
+	frame    uint64
+}
+
+// NewDamagePipeline crea un nuevo pipeline de daño
+func NewDamagePipeline(calc *DamageCalculator, events *EventSystem) *DamagePipeline {
+	return &DamagePipeline{
+		calc:     calc,
+		registry: NewHitRegistry(),
+		events:   events,
+	}
+}
+
+// SetFrame actualiza el frame actual y limpia registros viejos (llamar cada frame)
+func (dp *DamagePipeline) SetFrame(frame uint64) {
+	dp.frame = frame
+	if frame%60 == 0 {
+		dp.registry.Prune(frame, hitRegistryMaxAge)
+	}
+}
+
+// Reset limpia el registro de golpes (al reiniciar la pelea)
+func (dp *DamagePipeline) Reset() {
+	dp.registry.Clear()
+}
+
+// RegistrySize retorna el tamaño del registro de golpes (para debug)
+func (dp *DamagePipeline) RegistrySize() int {
+	return dp.registry.Size()
+}
+
+// Process pasa un golpe por todo el pipeline y emite los eventos correspondientes
+func (dp *DamagePipeline) Process(hit Hit, target Damageable) HitOutcome {
+	targetID := target.GetActorID()
+
+	// 0. Fuego amigo: lo deciden las facciones
+	if !CanHarm(hit.Attacker, targetID) {
+		return HitOutcome{Result: HitIgnored}
+	}
+
+	// 1. Una instancia de ataque solo conecta una vez por objetivo
+	if dp.registry.HasHit(hit.AttackID, targetID) {
+		return HitOutcome{Result: HitIgnored}
+	}
+
+	// 2. Ventanas de invulnerabilidad
+	if !target.CanReceiveHit() {
+		return HitOutcome{Result: HitIgnored}
+	}
+
+	// 3. I-frames (esquiva)
+	if evader, ok := target.(Evader); ok {
+		if evaded, perfect := evader.TryEvade(!hit.Contact); evaded {
+			dp.registry.Register(hit.AttackID, targetID, dp.frame)
+			dp.emitDodge(hit, targetID, perfect)
+
+			if perfect {
+				return HitOutcome{Result: HitPerfectDodge}
+			}
+			return HitOutcome{Result: HitEvaded}
+		}
+	}
+
+	// 4. Calcular daño contra las defensas del objetivo
+	comboMultiplier := hit.ComboMultiplier
+	if comboMultiplier <= 0 {
+		comboMultiplier = 1.0
+	}
+	var defenses Defenses
+	if defender, ok := target.(Defender); ok {
+		defenses = defender.GetDefenses()
+	}
+	isCritical := hit.CritChance > 0 && dp.calc.RollCritical(hit.CritChance)
+	breakdown := dp.calc.CalculateDamageBreakdown(hit.BaseDamage, hit.DamageType, isCritical, comboMultiplier, defenses)
+	damage := breakdown.Final
+
+	result := HitLanded
+
+	// 5. Guardia
+	if guarder, ok := target.(Guarder); ok {
+		guard, remaining := guarder.ResolveGuard(&hit, damage)
+
+		switch guard {
+		case GuardParried:
+			dp.registry.Register(hit.AttackID, targetID, dp.frame)
+			dp.emit(EventParry, hit, targetID, damage, false, nil)
+			return HitOutcome{Result: HitParried}
+
+		case GuardBlocked:
+			dp.emit(EventBlock, hit, targetID, damage-remaining, false, nil)
+			damage = remaining
+			result = HitBlocked
+		}
+	}
+
+	// 6. Knockback proporcional al daño
+	knockbackForce := dp.calc.CalculateKnockback(damage, hit.BaseKnockback)
+	if result == HitBlocked {
+		knockbackForce *= 0.3
+	}
+	knockback := hit.Direction.Normalize().Mul(knockbackForce)
+
+	// 7. Aplicar daño
+	dp.registry.Register(hit.AttackID, targetID, dp.frame)
+
+	if damage <= 0 {
+		return HitOutcome{Result: result, Knockback: knockback}
+	}
+
+	if !target.ReceiveDamage(damage, knockback) {
+		return HitOutcome{Result: HitIgnored}
+	}
+	dp.registry.MarkLanded(hit.AttackID, dp.frame)
+
+	// 8. Efectos de estado del golpe
+	if holder, ok := target.(StatusHolder); ok {
+		for _, statusType := range hit.Inflicts {
+			holder.GetStatusEffects().Apply(statusType)
+		}
+	}
+
+	// 9. Postura (el daño bloqueado desgasta menos)
+	staggered := false
+	if staggerable, ok := target.(Staggerable); ok {
+		poiseDamage := hit.PoiseDamage
+		if poiseDamage <= 0 {
+			poiseDamage = 1.0
+		}
+		staggered = staggerable.ApplyPoiseDamage(float64(damage) * poiseDamage)
+	}
+
+	// 10. Interrupción o armadura (si la postura ya se rompió no hace falta)
+	interrupt := InterruptNone
+	if interruptible, ok := target.(Interruptible); ok && !staggered {
+		interrupt = interruptible.ResolveInterrupt(&hit)
+	}
+
+	// 11. Eventos (iguales para ambos lados)
+	breakdown.Final = damage // Tras la guardia
+	dp.emit(EventDamageDealt, hit, targetID, damage, isCritical, &breakdown)
+	dp.emit(EventDamageTaken, hit, targetID, damage, isCritical, &breakdown)
+	dp.emit(EventAttackLanded, hit, targetID, damage, isCritical, &breakdown)
+	if isCritical {
+		dp.emit(EventCriticalHit, hit, targetID, damage, true, &breakdown)
+	}
+	if staggered {
+		dp.emit(EventStagger, hit, targetID, damage, isCritical, nil)
+	}
+	switch interrupt {
+	case InterruptFlinched:
+		dp.emit(EventFlinch, hit, targetID, damage, isCritical, nil)
+	case InterruptArmored:
+		dp.emit(EventArmorHit, hit, targetID, damage, isCritical, nil)
+	}
+
+	return HitOutcome{
+		Result:     result,
+		Damage:     damage,
+		IsCritical: isCritical,
+		Knockback:  knockback,
+		Breakdown:  breakdown,
+	}
+}
+
+// FinishAttack se llama cuando termina una instancia de ataque (fin del swing,
+// proyectil destruido). Si no hizo daño a nadie emite EventAttackMissed.
+func (dp *DamagePipeline) FinishAttack(attackID uint64, attackName string, attacker ActorID, position utils.Vector2) {
+	if attackID == 0 {
+		return
+	}
+
+	landed := dp.registry.HasLanded(attackID)
+	dp.registry.Forget(attackID)
+	if landed {
+		return
+	}
+
+	dp.events.EmitEvent(CombatEvent{
+		Type:     EventAttackMissed,
+		Position: position,
+		Attacker: attacker,
+		Metadata: map[string]interface{}{
+			"attack":    attackName,
+			"attack_id": attackID,
+		},
+	})
+}
+
+// emit envía un evento del golpe al sistema de eventos.
+// breakdown es nil en eventos sin daño calculado (esquiva, parry, bloqueo).
+func (dp *DamagePipeline) emit(eventType EventType, hit Hit, target ActorID, damage int, isCritical bool, breakdown *DamageBreakdown) {
+	metadata := map[string]interface{}{
+		"attack":      hit.AttackName,
+		"attack_id":   hit.AttackID,
+		"damage_type": hit.DamageType,
+	}
+	if breakdown != nil {
+		metadata["breakdown"] = *breakdown
+	}
+
+	dp.events.EmitEvent(CombatEvent{
+		Type:       eventType,
+		Damage:     damage,
+		Position:   hit.Position,
+		Attacker:   hit.Attacker,
+		Target:     target,
+		IsCritical: isCritical,
+		ComboCount: hit.ComboCount,
+		Metadata:   metadata,
+	})
+}
+
+// emitDodge emite EventDodge para toda esquiva (metadata "perfect" distingue las perfectas)
+func (dp *DamagePipeline) emitDodge(hit Hit, target ActorID, perfect bool) {
+	dp.events.EmitEvent(CombatEvent{
+		Type:     EventDodge,
+		Position: hit.Position,
+		Attacker: hit.Attacker,
+		Target:   target,
+		Metadata: map[string]interface{}{
+			"attack":    hit.AttackName,
+			"attack_id": hit.AttackID,
+			"perfect":   perfect,
+		},
+	})
+}
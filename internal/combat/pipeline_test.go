@@ -0,0 +1,449 @@
+package combat
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+)
+
+// ============================================================================
+// OBJETIVO DE PRUEBA
+// ============================================================================
+
+// dummy implementa todas las interfaces opcionales del pipeline y anota en
+// calls el orden en que se las consulta
+type dummy struct {
+	id       ActorID
+	invuln   bool
+	rejects  bool // ReceiveDamage retorna false
+	evade    bool
+	perfect  bool
+	guard    GuardResult
+	guardCut int // Daño que absorbe el bloqueo
+	defenses Defenses
+	breaks   bool // ApplyPoiseDamage rompe la postura
+	flinch   InterruptResult
+	status   *StatusEffects
+
+	calls    []string
+	received int
+}
+
+func newDummy() *dummy {
+	return &dummy{id: BossActor, status: NewStatusEffects()}
+}
+
+func (d *dummy) GetActorID() ActorID        { return d.id }
+func (d *dummy) GetPosition() utils.Vector2 { return utils.Vector2{} }
+func (d *dummy) CanReceiveHit() bool        { return !d.invuln }
+
+func (d *dummy) ReceiveDamage(damage int, knockback utils.Vector2) bool {
+	d.calls = append(d.calls, "receive")
+	if d.rejects {
+		return false
+	}
+	d.received += damage
+	return true
+}
+
+func (d *dummy) TryEvade(perfectEligible bool) (bool, bool) {
+	d.calls = append(d.calls, "evade")
+	return d.evade, d.evade && d.perfect && perfectEligible
+}
+
+func (d *dummy) GetDefenses() Defenses {
+	d.calls = append(d.calls, "defenses")
+	return d.defenses
+}
+
+func (d *dummy) ResolveGuard(hit *Hit, damage int) (GuardResult, int) {
+	d.calls = append(d.calls, "guard")
+	if d.guard == GuardBlocked {
+		return d.guard, damage - d.guardCut
+	}
+	return d.guard, damage
+}
+
+func (d *dummy) ApplyPoiseDamage(amount float64) bool {
+	d.calls = append(d.calls, "poise")
+	return d.breaks
+}
+
+func (d *dummy) ResolveInterrupt(hit *Hit) InterruptResult {
+	d.calls = append(d.calls, "interrupt")
+	return d.flinch
+}
+
+func (d *dummy) GetStatusEffects() *StatusEffects {
+	d.calls = append(d.calls, "status")
+	return d.status
+}
+
+// halfSource hace que rand.Float64 retorne siempre 0.5: variación x1.0 y
+// crítico solo con CritChance > 0.5
+type halfSource struct{}
+
+func (halfSource) Int63() int64 { return 1 << 62 }
+func (halfSource) Seed(int64)   {}
+
+// newTestPipeline crea un pipeline determinista y un sistema de eventos sin arrancar
+func newTestPipeline() (*DamagePipeline, *EventSystem) {
+	events := NewEventSystem(64)
+	calc := &DamageCalculator{rng: rand.New(halfSource{})}
+	return NewDamagePipeline(calc, events), events
+}
+
+// drainEvents procesa lo emitido y retorna los tipos en orden
+func drainEvents(es *EventSystem) []EventType {
+	var got []EventType
+	for eventType := EventDamageDealt; eventType <= EventArmorHit; eventType++ {
+		es.AddListener(eventType, func(event CombatEvent) { got = append(got, event.Type) })
+	}
+	es.Start()
+	es.Stop()
+	es.Dispatch()
+	return got
+}
+
+// ============================================================================
+// TESTS
+// ============================================================================
+
+func TestDamagePipelineProcess(t *testing.T) {
+	landedEvents := []EventType{EventDamageDealt, EventDamageTaken, EventAttackLanded}
+	fullPath := []string{"evade", "defenses", "guard", "receive", "status", "poise", "interrupt"}
+
+	tests := []struct {
+		name     string
+		hit      Hit
+		setup    func(*dummy)
+		result   HitResult
+		damage   int
+		calls    []string
+		events   []EventType
+		received int
+	}{
+		{
+			name:   "fuego amigo",
+			hit:    Hit{Attacker: BossActor},
+			setup:  func(d *dummy) { d.id = NewActorID(ActorKindMinion, 0, FactionEnemy) },
+			result: HitIgnored,
+		},
+		{
+			name:   "invulnerable",
+			setup:  func(d *dummy) { d.invuln = true },
+			result: HitIgnored,
+		},
+		{
+			name:   "esquiva",
+			setup:  func(d *dummy) { d.evade = true },
+			result: HitEvaded,
+			calls:  []string{"evade"},
+			events: []EventType{EventDodge},
+		},
+		{
+			name:   "esquiva perfecta",
+			setup:  func(d *dummy) { d.evade, d.perfect = true, true },
+			result: HitPerfectDodge,
+			calls:  []string{"evade"},
+			events: []EventType{EventDodge},
+		},
+		{
+			name:   "el contacto no cuenta como esquiva perfecta",
+			hit:    Hit{Contact: true},
+			setup:  func(d *dummy) { d.evade, d.perfect = true, true },
+			result: HitEvaded,
+			calls:  []string{"evade"},
+			events: []EventType{EventDodge},
+		},
+		{
+			name:   "parry",
+			setup:  func(d *dummy) { d.guard = GuardParried },
+			result: HitParried,
+			calls:  []string{"evade", "defenses", "guard"},
+			events: []EventType{EventParry},
+		},
+		{
+			name:     "bloqueo",
+			setup:    func(d *dummy) { d.guard, d.guardCut = GuardBlocked, 6 },
+			result:   HitBlocked,
+			damage:   4,
+			calls:    fullPath,
+			events:   append([]EventType{EventBlock}, landedEvents...),
+			received: 4,
+		},
+		{
+			name:   "bloqueo total: no aplica daño",
+			setup:  func(d *dummy) { d.guard, d.guardCut = GuardBlocked, 10 },
+			result: HitBlocked,
+			calls:  []string{"evade", "defenses", "guard"},
+			events: []EventType{EventBlock},
+		},
+		{
+			name:     "guardia rota: daño completo",
+			setup:    func(d *dummy) { d.guard = GuardBroken },
+			result:   HitLanded,
+			damage:   10,
+			calls:    fullPath,
+			events:   landedEvents,
+			received: 10,
+		},
+		{
+			name:     "crítico",
+			hit:      Hit{CritChance: 1},
+			result:   HitLanded,
+			damage:   15,
+			calls:    fullPath,
+			events:   append(append([]EventType{}, landedEvents...), EventCriticalHit),
+			received: 15,
+		},
+		{
+			name:     "postura rota: no se consulta la interrupción",
+			setup:    func(d *dummy) { d.breaks = true },
+			result:   HitLanded,
+			damage:   10,
+			calls:    []string{"evade", "defenses", "guard", "receive", "status", "poise"},
+			events:   append(append([]EventType{}, landedEvents...), EventStagger),
+			received: 10,
+		},
+		{
+			name:     "interrumpido",
+			setup:    func(d *dummy) { d.flinch = InterruptFlinched },
+			result:   HitLanded,
+			damage:   10,
+			calls:    fullPath,
+			events:   append(append([]EventType{}, landedEvents...), EventFlinch),
+			received: 10,
+		},
+		{
+			name:     "armadura",
+			setup:    func(d *dummy) { d.flinch = InterruptArmored },
+			result:   HitLanded,
+			damage:   10,
+			calls:    fullPath,
+			events:   append(append([]EventType{}, landedEvents...), EventArmorHit),
+			received: 10,
+		},
+		{
+			name:   "el objetivo rechaza el daño",
+			setup:  func(d *dummy) { d.rejects = true },
+			result: HitIgnored,
+			calls:  []string{"evade", "defenses", "guard", "receive"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline, events := newTestPipeline()
+			target := newDummy()
+			if tt.setup != nil {
+				tt.setup(target)
+			}
+
+			hit := tt.hit
+			if hit.Attacker == (ActorID{}) {
+				hit.Attacker = PlayerActor
+			}
+			hit.AttackID = NewAttackID()
+			hit.BaseDamage = 10
+			hit.DamageType = DamagePhysical
+			hit.Direction = utils.Vector2{X: 1}
+			hit.Inflicts = []StatusType{StatusBurn} // Para ver cuándo se aplican los efectos
+
+			outcome := pipeline.Process(hit, target)
+
+			if outcome.Result != tt.result {
+				t.Errorf("Result = %v, se esperaba %v", outcome.Result, tt.result)
+			}
+			if outcome.Damage != tt.damage {
+				t.Errorf("Damage = %d, se esperaba %d", outcome.Damage, tt.damage)
+			}
+			if target.received != tt.received {
+				t.Errorf("el objetivo recibió %d, se esperaba %d", target.received, tt.received)
+			}
+			if !reflect.DeepEqual(target.calls, tt.calls) {
+				t.Errorf("orden de llamadas %v, se esperaba %v", target.calls, tt.calls)
+			}
+			if got := drainEvents(events); !reflect.DeepEqual(got, tt.events) {
+				t.Errorf("eventos %v, se esperaba %v", got, tt.events)
+			}
+		})
+	}
+}
+
+func TestDamagePipelineRegistry(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     func() uint64 // nil = un ataque nuevo en cada golpe
+		first  func(*dummy)  // Estado del objetivo en el primer golpe
+		second HitResult
+	}{
+		{
+			name:   "el mismo ataque conecta una vez",
+			id:     constID(NewAttackID()),
+			second: HitIgnored,
+		},
+		{
+			name:   "ataques distintos conectan los dos",
+			second: HitLanded,
+		},
+		{
+			name:   "el contacto (ID 0) no se registra",
+			id:     constID(0),
+			second: HitLanded,
+		},
+		{
+			name:   "un ataque esquivado no vuelve a conectar",
+			id:     constID(NewAttackID()),
+			first:  func(d *dummy) { d.evade = true },
+			second: HitIgnored,
+		},
+		{
+			name:   "un golpe contra un invulnerable no se registra",
+			id:     constID(NewAttackID()),
+			first:  func(d *dummy) { d.invuln = true },
+			second: HitLanded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline, _ := newTestPipeline()
+			target := newDummy()
+
+			nextID := tt.id
+			if nextID == nil {
+				nextID = NewAttackID
+			}
+			hit := func() HitOutcome {
+				return pipeline.Process(Hit{AttackID: nextID(), Attacker: PlayerActor, BaseDamage: 10}, target)
+			}
+
+			if tt.first != nil {
+				tt.first(target)
+			}
+			hit()
+			*target = dummy{id: target.id, status: target.status}
+
+			if got := hit().Result; got != tt.second {
+				t.Errorf("segundo golpe: %v, se esperaba %v", got, tt.second)
+			}
+		})
+	}
+}
+
+// constID retorna siempre el mismo ID de ataque
+func constID(id uint64) func() uint64 {
+	return func() uint64 { return id }
+}
+
+func TestDamagePipelineBreakdown(t *testing.T) {
+	defenses := Defenses{
+		Defense:       2,
+		Resistances:   map[DamageType]float64{DamageFire: 0.25},
+		Vulnerability: 0.5,
+	}
+
+	tests := []struct {
+		name       string
+		damageType DamageType
+		guardCut   int
+		want       DamageBreakdown
+	}{
+		{
+			// 20 x1.5 = 30 → -25% = 22.5 → -2 = 20.5 → +50% = 30.75
+			name:       "resistencia, defensa y vulnerabilidad",
+			damageType: DamageFire,
+			want: DamageBreakdown{
+				Type: DamageFire, Base: 20, ComboMultiplier: 1.5, Variance: 1, Raw: 30,
+				Resistance: 0.25, Defense: 2, Vulnerability: 0.5, Final: 30,
+			},
+		},
+		{
+			// Sin resistencia ni defensa; la vulnerabilidad sí cuenta
+			name:       "el daño verdadero ignora las defensas",
+			damageType: DamageTrue,
+			want: DamageBreakdown{
+				Type: DamageTrue, Base: 20, ComboMultiplier: 1.5, Variance: 1, Raw: 30,
+				Vulnerability: 0.5, Final: 45,
+			},
+		},
+		{
+			// Final refleja el daño tras la guardia
+			name:       "el desglose guarda el daño bloqueado",
+			damageType: DamageTrue,
+			guardCut:   40,
+			want: DamageBreakdown{
+				Type: DamageTrue, Base: 20, ComboMultiplier: 1.5, Variance: 1, Raw: 30,
+				Vulnerability: 0.5, Final: 5,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline, events := newTestPipeline()
+			target := newDummy()
+			target.defenses = defenses
+			if tt.guardCut > 0 {
+				target.guard, target.guardCut = GuardBlocked, tt.guardCut
+			}
+
+			var logged []DamageBreakdown
+			events.AddListener(EventDamageDealt, func(event CombatEvent) {
+				logged = append(logged, event.Metadata["breakdown"].(DamageBreakdown))
+			})
+
+			outcome := pipeline.Process(Hit{
+				AttackID:        NewAttackID(),
+				Attacker:        PlayerActor,
+				BaseDamage:      20,
+				DamageType:      tt.damageType,
+				ComboMultiplier: 1.5,
+				BaseKnockback:   10,
+				Direction:       utils.Vector2{X: -3},
+			}, target)
+			drainEvents(events)
+
+			if !reflect.DeepEqual(outcome.Breakdown, tt.want) {
+				t.Errorf("desglose %+v, se esperaba %+v", outcome.Breakdown, tt.want)
+			}
+			if outcome.Damage != tt.want.Final {
+				t.Errorf("Damage = %d, el desglose dice %d", outcome.Damage, tt.want.Final)
+			}
+			if len(logged) != 1 || !reflect.DeepEqual(logged[0], tt.want) {
+				t.Errorf("el evento llevó %+v, se esperaba %+v", logged, tt.want)
+			}
+
+			// Knockback en la dirección del golpe, más débil si se bloqueó
+			force := 10 * (1 + float64(tt.want.Final)/100)
+			if tt.guardCut > 0 {
+				force *= 0.3
+			}
+			if math.Abs(outcome.Knockback.X+force) > 1e-9 || outcome.Knockback.Y != 0 {
+				t.Errorf("Knockback = %+v, se esperaba (%v, 0)", outcome.Knockback, -force)
+			}
+		})
+	}
+}
+
+func TestDamagePipelineInflicts(t *testing.T) {
+	pipeline, _ := newTestPipeline()
+	target := newDummy()
+
+	pipeline.Process(Hit{AttackID: NewAttackID(), Attacker: PlayerActor, BaseDamage: 10, Inflicts: []StatusType{StatusBurn}}, target)
+	if !target.status.Has(StatusBurn) {
+		t.Error("el golpe que conecta debería aplicar la quemadura")
+	}
+
+	// Un golpe esquivado no aplica nada
+	dodger := newDummy()
+	dodger.evade = true
+	pipeline.Process(Hit{AttackID: NewAttackID(), Attacker: PlayerActor, BaseDamage: 10, Inflicts: []StatusType{StatusBurn}}, dodger)
+	if dodger.status.Has(StatusBurn) {
+		t.Error("un golpe esquivado no debería aplicar efectos")
+	}
+}
@@ -0,0 +1,44 @@
+package combat
+
+// ============================================================================
+// DEFENSAS Y RESISTENCIAS
+// ============================================================================
+
+// Límites de resistencia: nunca inmune, como mucho el doble de daño
+const (
+	maxResistance = 0.9
+	minResistance = -1.0
+)
+
+// Defenses contiene la defensa plana y las resistencias por tipo de daño
+type Defenses struct {
+	Defense       int                    // Daño restado a cada golpe (no afecta a DamageTrue)
+	Resistances   map[DamageType]float64 // Fracción resistida (0.25 = -25%, negativa = debilidad)
+	Vulnerability float64                // Daño extra a todo (0.5 = +50%, ej. postura rota o estado Vulnerable)
+}
+
+// Resistance retorna la resistencia a un tipo de daño, acotada a los límites
+func (d Defenses) Resistance(damageType DamageType) float64 {
+	if damageType == DamageTrue {
+		return 0
+	}
+
+	resistance := d.Resistances[damageType]
+	if resistance > maxResistance {
+		return maxResistance
+	}
+	if resistance < minResistance {
+		return minResistance
+	}
+	return resistance
+}
+
+// Defender es un objetivo con defensas (pueden cambiar en cada frame, ej. por fase)
+type Defender interface {
+	GetDefenses() Defenses
+}
+
+// StatusHolder es un objetivo que puede recibir efectos de estado al ser golpeado
+type StatusHolder interface {
+	GetStatusEffects() *StatusEffects
+}
@@ -0,0 +1,374 @@
+package combat
+
+import (
+	"image/color"
+	"sort"
+)
+
+// StatusType representa el tipo de efecto de estado
+type StatusType int
+
+const (
+	StatusStun       StatusType = iota // No puede actuar
+	StatusSlow                         // Movimiento reducido
+	StatusBurn                         // Daño por tiempo
+	StatusVulnerable                   // Recibe más daño
+	StatusRegen                        // Recupera vida con el tiempo
+)
+
+// String retorna el nombre del efecto (para debug y eventos)
+func (s StatusType) String() string {
+	switch s {
+	case StatusStun:
+		return "Stun"
+	case StatusSlow:
+		return "Slow"
+	case StatusBurn:
+		return "Burn"
+	case StatusVulnerable:
+		return "Vulnerable"
+	case StatusRegen:
+		return "Regen"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText exporta el efecto por nombre (JSON)
+func (s StatusType) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Icon retorna la letra que se dibuja en el icono del HUD
+func (s StatusType) Icon() string {
+	switch s {
+	case StatusStun:
+		return "S"
+	case StatusSlow:
+		return "L"
+	case StatusBurn:
+		return "B"
+	case StatusVulnerable:
+		return "V"
+	case StatusRegen:
+		return "R"
+	default:
+		return "?"
+	}
+}
+
+// Color retorna el color del icono del HUD
+func (s StatusType) Color() color.RGBA {
+	switch s {
+	case StatusStun:
+		return color.RGBA{255, 255, 0, 255} // Amarillo
+	case StatusSlow:
+		return color.RGBA{100, 150, 255, 255} // Azul
+	case StatusBurn:
+		return color.RGBA{255, 100, 0, 255} // Naranja
+	case StatusVulnerable:
+		return color.RGBA{200, 0, 200, 255} // Morado
+	case StatusRegen:
+		return color.RGBA{0, 255, 100, 255} // Verde
+	default:
+		return color.RGBA{255, 255, 255, 255}
+	}
+}
+
+// StackRule define cómo se combinan aplicaciones repetidas del mismo efecto
+type StackRule int
+
+const (
+	StackRefresh   StackRule = iota // Reinicia la duración
+	StackIntensity                  // Suma stacks (hasta MaxStacks) y reinicia la duración
+	StackIgnore                     // No se reaplica mientras esté activo
+)
+
+// StatusDefinition contiene la configuración de un tipo de efecto
+type StatusDefinition struct {
+	Duration       int       // Duración por defecto (frames)
+	Stacking       StackRule // Regla de acumulación
+	MaxStacks      int       // Máximo de stacks (StackIntensity)
+	Magnitude      float64   // Slow: factor de velocidad, Burn/Regen: cantidad por tick, Vulnerable: daño extra
+	TickInterval   int       // Frames entre ticks (Burn/Regen)
+	ImmunityFrames int       // Frames de inmunidad al expirar
+}
+
+// DefaultStatusDefinitions retorna la configuración por defecto de cada efecto
+func DefaultStatusDefinitions() map[StatusType]StatusDefinition {
+	return map[StatusType]StatusDefinition{
+		StatusStun: {
+			Duration:       60, // 1 segundo
+			Stacking:       StackIgnore,
+			MaxStacks:      1,
+			ImmunityFrames: 120, // 2 segundos sin poder ser aturdido otra vez
+		},
+		StatusSlow: {
+			Duration:  120,
+			Stacking:  StackRefresh,
+			MaxStacks: 1,
+			Magnitude: 0.5, // 50% de velocidad
+		},
+		StatusBurn: {
+			Duration:     180,
+			Stacking:     StackIntensity,
+			MaxStacks:    3,
+			Magnitude:    2, // Daño por tick y por stack
+			TickInterval: 30,
+		},
+		StatusVulnerable: {
+			Duration:  180,
+			Stacking:  StackRefresh,
+			MaxStacks: 1,
+			Magnitude: 0.5, // +50% de daño recibido
+		},
+		StatusRegen: {
+			Duration:     300,
+			Stacking:     StackRefresh,
+			MaxStacks:    1,
+			Magnitude:    2, // Vida por tick
+			TickInterval: 30,
+		},
+	}
+}
+
+// StatusEffect representa un efecto activo sobre una entidad
+type StatusEffect struct {
+	Type      StatusType
+	TimeLeft  int
+	Duration  int
+	Stacks    int
+	Magnitude float64
+	tickTimer int
+}
+
+// StatusChangeKind indica qué le pasó a un efecto
+type StatusChangeKind int
+
+const (
+	StatusChangeApplied StatusChangeKind = iota
+	StatusChangeExpired
+	StatusChangeTick
+)
+
+// StatusChange es una notificación que el juego convierte en CombatEvent
+type StatusChange struct {
+	Kind   StatusChangeKind
+	Type   StatusType
+	Stacks int
+	Amount int // Daño o curación del tick
+}
+
+// StatusEffects maneja los efectos de estado de una entidad.
+// Se actualiza en el hilo del juego junto con la entidad (no es thread-safe).
+type StatusEffects struct {
+	effects     map[StatusType]*StatusEffect
+	immunity    map[StatusType]int
+	definitions map[StatusType]StatusDefinition
+	changes     []StatusChange
+}
+
+// NewStatusEffects crea un contenedor de efectos con la configuración por defecto
+func NewStatusEffects() *StatusEffects {
+	return &StatusEffects{
+		effects:     make(map[StatusType]*StatusEffect),
+		immunity:    make(map[StatusType]int),
+		definitions: DefaultStatusDefinitions(),
+		changes:     make([]StatusChange, 0, 4),
+	}
+}
+
+// Apply aplica un efecto con su duración por defecto
+func (se *StatusEffects) Apply(statusType StatusType) bool {
+	def := se.definitions[statusType]
+	return se.ApplyWithDuration(statusType, def.Duration)
+}
+
+// ApplyWithDuration aplica un efecto con una duración específica.
+// Retorna false si la entidad es inmune o la regla de stacking lo impide.
+func (se *StatusEffects) ApplyWithDuration(statusType StatusType, duration int) bool {
+	if se.IsImmune(statusType) || duration <= 0 {
+		return false
+	}
+
+	def := se.definitions[statusType]
+
+	if effect, exists := se.effects[statusType]; exists {
+		switch def.Stacking {
+		case StackIgnore:
+			return false
+		case StackIntensity:
+			if effect.Stacks < def.MaxStacks {
+				effect.Stacks++
+			}
+		}
+
+		// Refrescar duración
+		if duration > effect.TimeLeft {
+			effect.TimeLeft = duration
+			effect.Duration = duration
+		}
+
+		se.changes = append(se.changes, StatusChange{
+			Kind:   StatusChangeApplied,
+			Type:   statusType,
+			Stacks: effect.Stacks,
+		})
+		return true
+	}
+
+	se.effects[statusType] = &StatusEffect{
+		Type:      statusType,
+		TimeLeft:  duration,
+		Duration:  duration,
+		Stacks:    1,
+		Magnitude: def.Magnitude,
+		tickTimer: def.TickInterval,
+	}
+
+	se.changes = append(se.changes, StatusChange{
+		Kind:   StatusChangeApplied,
+		Type:   statusType,
+		Stacks: 1,
+	})
+	return true
+}
+
+// Update avanza todos los efectos un frame.
+// Retorna el daño (Burn) y la curación (Regen) que deben aplicarse este frame.
+func (se *StatusEffects) Update() (damage, heal int) {
+	// Ventanas de inmunidad
+	for statusType, frames := range se.immunity {
+		if frames <= 1 {
+			delete(se.immunity, statusType)
+		} else {
+			se.immunity[statusType] = frames - 1
+		}
+	}
+
+	for statusType, effect := range se.effects {
+		def := se.definitions[statusType]
+
+		// Ticks periódicos
+		if def.TickInterval > 0 {
+			effect.tickTimer--
+			if effect.tickTimer <= 0 {
+				effect.tickTimer = def.TickInterval
+				amount := int(effect.Magnitude * float64(effect.Stacks))
+
+				switch statusType {
+				case StatusBurn:
+					damage += amount
+				case StatusRegen:
+					heal += amount
+				}
+
+				se.changes = append(se.changes, StatusChange{
+					Kind:   StatusChangeTick,
+					Type:   statusType,
+					Stacks: effect.Stacks,
+					Amount: amount,
+				})
+			}
+		}
+
+		effect.TimeLeft--
+		if effect.TimeLeft <= 0 {
+			se.expire(statusType)
+		}
+	}
+
+	return damage, heal
+}
+
+// expire elimina un efecto e inicia su ventana de inmunidad
+func (se *StatusEffects) expire(statusType StatusType) {
+	delete(se.effects, statusType)
+
+	if frames := se.definitions[statusType].ImmunityFrames; frames > 0 {
+		se.immunity[statusType] = frames
+	}
+
+	se.changes = append(se.changes, StatusChange{
+		Kind: StatusChangeExpired,
+		Type: statusType,
+	})
+}
+
+// Reduce acorta la duración de un efecto (por ejemplo, al machacar botones durante un stun)
+func (se *StatusEffects) Reduce(statusType StatusType, frames int) {
+	effect, exists := se.effects[statusType]
+	if !exists {
+		return
+	}
+
+	effect.TimeLeft -= frames
+	if effect.TimeLeft <= 0 {
+		se.expire(statusType)
+	}
+}
+
+// Remove elimina un efecto sin activar la inmunidad
+func (se *StatusEffects) Remove(statusType StatusType) {
+	delete(se.effects, statusType)
+}
+
+// Clear elimina todos los efectos, inmunidades y notificaciones pendientes
+func (se *StatusEffects) Clear() {
+	se.effects = make(map[StatusType]*StatusEffect)
+	se.immunity = make(map[StatusType]int)
+	se.changes = se.changes[:0]
+}
+
+// Has retorna true si el efecto está activo
+func (se *StatusEffects) Has(statusType StatusType) bool {
+	_, exists := se.effects[statusType]
+	return exists
+}
+
+// IsImmune retorna true si la entidad está en ventana de inmunidad para el efecto
+func (se *StatusEffects) IsImmune(statusType StatusType) bool {
+	return se.immunity[statusType] > 0
+}
+
+// SpeedMultiplier retorna el multiplicador de velocidad (Slow)
+func (se *StatusEffects) SpeedMultiplier() float64 {
+	if effect, exists := se.effects[StatusSlow]; exists {
+		return effect.Magnitude
+	}
+	return 1.0
+}
+
+// DamageTakenMultiplier retorna el multiplicador de daño recibido (Vulnerable)
+func (se *StatusEffects) DamageTakenMultiplier() float64 {
+	if effect, exists := se.effects[StatusVulnerable]; exists {
+		return 1.0 + effect.Magnitude*float64(effect.Stacks)
+	}
+	return 1.0
+}
+
+// Active retorna una COPIA de los efectos activos ordenados por tipo (para el HUD)
+func (se *StatusEffects) Active() []StatusEffect {
+	active := make([]StatusEffect, 0, len(se.effects))
+	for _, effect := range se.effects {
+		active = append(active, *effect)
+	}
+
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].Type < active[j].Type
+	})
+
+	return active
+}
+
+// DrainChanges retorna y limpia las notificaciones pendientes
+func (se *StatusEffects) DrainChanges() []StatusChange {
+	if len(se.changes) == 0 {
+		return nil
+	}
+
+	changes := make([]StatusChange, len(se.changes))
+	copy(changes, se.changes)
+	se.changes = se.changes[:0]
+	return changes
+}
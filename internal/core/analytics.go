@@ -0,0 +1,285 @@
+package core
+
+import (
+	"fmt"
+	"image/color"
+	"sort"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
+	"github.com/MarcosBrindis/boss-arena-go/internal/entities"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// ============================================================================
+// ANALÍTICA DE LA PELEA
+// ============================================================================
+
+// analyticsBucketFrames es el ancho de cada punto del gráfico (1 segundo)
+const analyticsBucketFrames = 60
+
+// phaseMarker marca el frame (relativo al inicio) en que empezó una fase
+type phaseMarker struct {
+	frame uint64
+	phase entities.BossPhase
+}
+
+// FightAnalytics registra la pelea frame a frame para el reporte final.
+// Se alimenta desde el hilo del juego (Sample en Update, eventos en Dispatch).
+type FightAnalytics struct {
+	startFrame uint64
+	frames     uint64 // Duración (se congela al terminar la pelea)
+	finished   bool
+
+	// Daño por segundo de pelea
+	dealt []int
+	taken []int
+
+	// Fases
+	markers     []phaseMarker
+	phaseDamage map[entities.BossPhase]int
+	phaseFrames map[entities.BossPhase]uint64
+
+	// Golpes del jugador
+	hitsByAttack     map[string]int
+	biggestHit       int
+	biggestHitAttack string
+
+	// Tiempo del boss en cada estado (frames)
+	stateFrames map[entities.BossState]uint64
+}
+
+// NewFightAnalytics crea la analítica vacía
+func NewFightAnalytics() *FightAnalytics {
+	fa := &FightAnalytics{}
+	fa.Start(0)
+	return fa
+}
+
+// Start reinicia la analítica para una pelea que empieza en frame
+func (fa *FightAnalytics) Start(frame uint64) {
+	fa.startFrame = frame
+	fa.frames = 0
+	fa.finished = false
+	fa.dealt = fa.dealt[:0]
+	fa.taken = fa.taken[:0]
+	fa.markers = []phaseMarker{{frame: 0, phase: entities.Phase1}}
+	fa.phaseDamage = make(map[entities.BossPhase]int)
+	fa.phaseFrames = make(map[entities.BossPhase]uint64)
+	fa.hitsByAttack = make(map[string]int)
+	fa.biggestHit = 0
+	fa.biggestHitAttack = ""
+	fa.stateFrames = make(map[entities.BossState]uint64)
+}
+
+// Finish congela la duración de la pelea
+func (fa *FightAnalytics) Finish(frame uint64) {
+	if fa.finished {
+		return
+	}
+	fa.frames = fa.relative(frame)
+	fa.finished = true
+}
+
+// Sample registra el estado del boss en un frame de lógica
+func (fa *FightAnalytics) Sample(frame uint64, state entities.BossState, phase entities.BossPhase) {
+	if fa.finished {
+		return
+	}
+	fa.frames = fa.relative(frame)
+
+	if last := fa.markers[len(fa.markers)-1]; last.phase != phase {
+		fa.markers = append(fa.markers, phaseMarker{frame: fa.frames, phase: phase})
+	}
+	fa.phaseFrames[phase]++
+	fa.stateFrames[state]++
+}
+
+// RecordEvent registra un evento de combate (listener en el hilo del juego)
+func (fa *FightAnalytics) RecordEvent(event combat.CombatEvent) {
+	// Los eventos llegan con retraso: el golpe final se despacha tras Finish
+	frame := fa.relative(event.Frame)
+	if event.Frame < fa.startFrame || (fa.finished && frame > fa.frames) {
+		return
+	}
+
+	switch event.Type {
+	case combat.EventDamageTaken:
+		// Incluye el daño por tiempo (quemaduras), que no tiene atacante
+		// El daño a los minions no cuenta para el DPS contra el boss
+		switch {
+		case event.Target.Faction == combat.FactionPlayer:
+			fa.addDamage(&fa.taken, frame, event.Damage)
+		case event.Target.Kind == combat.ActorKindBoss:
+			fa.addDamage(&fa.dealt, frame, event.Damage)
+			fa.phaseDamage[fa.phaseAt(frame)] += event.Damage
+		}
+
+	case combat.EventAttackLanded:
+		if event.Attacker.Faction != combat.FactionPlayer {
+			return
+		}
+		attack, _ := event.Metadata["attack"].(string)
+		fa.hitsByAttack[attack]++
+		if event.Damage > fa.biggestHit {
+			fa.biggestHit = event.Damage
+			fa.biggestHitAttack = attack
+		}
+	}
+}
+
+// relative convierte un frame del juego en un frame de la pelea
+func (fa *FightAnalytics) relative(frame uint64) uint64 {
+	if frame < fa.startFrame {
+		return 0
+	}
+	return frame - fa.startFrame
+}
+
+// addDamage suma daño al segundo correspondiente
+func (fa *FightAnalytics) addDamage(series *[]int, frame uint64, damage int) {
+	bucket := int(frame / analyticsBucketFrames)
+	for len(*series) <= bucket {
+		*series = append(*series, 0)
+	}
+	(*series)[bucket] += damage
+}
+
+// phaseAt retorna la fase del boss en un frame de la pelea
+func (fa *FightAnalytics) phaseAt(frame uint64) entities.BossPhase {
+	phase := fa.markers[0].phase
+	for _, marker := range fa.markers {
+		if marker.frame > frame {
+			break
+		}
+		phase = marker.phase
+	}
+	return phase
+}
+
+// PhaseDPS retorna el daño por segundo del jugador durante una fase
+func (fa *FightAnalytics) PhaseDPS(phase entities.BossPhase) float64 {
+	frames := fa.phaseFrames[phase]
+	if frames == 0 {
+		return 0
+	}
+	return float64(fa.phaseDamage[phase]) / (float64(frames) / 60.0)
+}
+
+// Seconds retorna la duración de la pelea en segundos
+func (fa *FightAnalytics) Seconds() float64 {
+	return float64(fa.frames) / 60.0
+}
+
+// ============================================================================
+// REPORTE FINAL
+// ============================================================================
+
+// drawFightReport dibuja el gráfico y el desglose de la pelea en las pantallas finales
+func (g *Game) drawFightReport(screen *ebiten.Image, x, y float32) {
+	fa := g.analytics
+
+	const graphWidth, graphHeight = 560, 200
+	g.drawDamageGraph(screen, x, y, graphWidth, graphHeight)
+
+	// DPS por fase
+	text := fmt.Sprintf("Duración: %.1fs\n\nDPS por fase:\n", fa.Seconds())
+	for _, phase := range []entities.BossPhase{entities.Phase1, entities.Phase2, entities.Phase3} {
+		if fa.phaseFrames[phase] == 0 {
+			continue
+		}
+		text += fmt.Sprintf("  %s: %.1f (%.1fs)\n", phase, fa.PhaseDPS(phase), float64(fa.phaseFrames[phase])/60.0)
+	}
+
+	// Golpes por ataque
+	text += "\nGolpes por ataque:\n"
+	attacks := make([]string, 0, len(fa.hitsByAttack))
+	for attack := range fa.hitsByAttack {
+		attacks = append(attacks, attack)
+	}
+	sort.Slice(attacks, func(i, j int) bool {
+		return fa.hitsByAttack[attacks[i]] > fa.hitsByAttack[attacks[j]]
+	})
+	for _, attack := range attacks {
+		text += fmt.Sprintf("  %-20s %d\n", attack, fa.hitsByAttack[attack])
+	}
+	if fa.biggestHit > 0 {
+		text += fmt.Sprintf("\nGolpe más fuerte: %d (%s)\n", fa.biggestHit, fa.biggestHitAttack)
+	}
+	ebitenutil.DebugPrintAt(screen, text, int(x), int(y+graphHeight+24))
+
+	// Tiempo del boss en cada estado
+	states := make([]entities.BossState, 0, len(fa.stateFrames))
+	for state := range fa.stateFrames {
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return fa.stateFrames[states[i]] > fa.stateFrames[states[j]]
+	})
+	stateText := "Tiempo del boss por estado:\n"
+	for _, state := range states {
+		stateText += fmt.Sprintf("  %-11s %5.1fs\n", state, float64(fa.stateFrames[state])/60.0)
+	}
+	ebitenutil.DebugPrintAt(screen, stateText, int(x+graphWidth/2+40), int(y+graphHeight+24))
+}
+
+// drawDamageGraph dibuja el daño hecho y recibido por segundo, con marcas de fase
+func (g *Game) drawDamageGraph(screen *ebiten.Image, x, y, width, height float32) {
+	fa := g.analytics
+
+	vector.DrawFilledRect(screen, x, y, width, height, color.RGBA{0, 0, 0, 160}, false)
+	vector.StrokeRect(screen, x, y, width, height, 1, color.RGBA{200, 200, 200, 255}, false)
+
+	buckets := int(fa.frames/analyticsBucketFrames) + 1
+	if buckets < 2 {
+		buckets = 2
+	}
+
+	maxDamage := 1
+	for _, series := range [][]int{fa.dealt, fa.taken} {
+		for _, damage := range series {
+			if damage > maxDamage {
+				maxDamage = damage
+			}
+		}
+	}
+
+	pointX := func(bucket int) float32 {
+		return x + float32(bucket)*width/float32(buckets-1)
+	}
+	pointY := func(damage int) float32 {
+		return y + height - float32(damage)*(height-10)/float32(maxDamage)
+	}
+
+	// Marcas de fase
+	for _, marker := range fa.markers[1:] {
+		mx := x + float32(marker.frame)/float32(analyticsBucketFrames)*width/float32(buckets-1)
+		r, gr, b := marker.phase.GetColor()
+		vector.StrokeLine(screen, mx, y, mx, y+height, 1, color.RGBA{r, gr, b, 255}, false)
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("F%d", int(marker.phase)+1), int(mx)+3, int(y)+2)
+	}
+
+	// Series
+	drawSeries := func(series []int, col color.RGBA) {
+		for bucket := 1; bucket < buckets; bucket++ {
+			vector.StrokeLine(screen,
+				pointX(bucket-1), pointY(damageAt(series, bucket-1)),
+				pointX(bucket), pointY(damageAt(series, bucket)),
+				2, col, false)
+		}
+	}
+	drawSeries(fa.dealt, color.RGBA{100, 255, 100, 255})
+	drawSeries(fa.taken, color.RGBA{255, 90, 90, 255})
+
+	// Leyenda
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Daño por segundo (máx %d)   verde = hecho   rojo = recibido", maxDamage), int(x), int(y)-18)
+}
+
+// damageAt retorna el daño de un segundo (0 si no hubo)
+func damageAt(series []int, bucket int) int {
+	if bucket < len(series) {
+		return series[bucket]
+	}
+	return 0
+}
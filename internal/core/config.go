@@ -15,6 +15,7 @@ type Config struct {
 	PlayerStartHP   int
 	BossStartHP     int
 	MeteorSpawnRate time.Duration
+	RushHeal        float64 // Vida que recupera el jugador entre bosses del boss rush (0.5 = la mitad de lo perdido)
 
 	// Concurrencia
 	NumPhysicsWorkers    int
@@ -27,6 +28,10 @@ type Config struct {
 	GamepadDeadzone  float64
 	JumpBufferFrames int
 	CoyoteTimeFrames int
+
+	// Registro de eventos (JSONL, un archivo por pelea)
+	EnableEventLog bool
+	EventLogDir    string
 }
 
 // DefaultConfig retorna la configuración por defecto
@@ -43,6 +48,7 @@ func DefaultConfig() *Config {
 		PlayerStartHP:   100,
 		BossStartHP:     500,
 		MeteorSpawnRate: 5 * time.Second,
+		RushHeal:        0.5,
 
 		// Concurrencia
 		NumPhysicsWorkers:    4,
@@ -55,6 +61,10 @@ func DefaultConfig() *Config {
 		GamepadDeadzone:  0.2, // 20% deadzone para sticks analógicos
 		JumpBufferFrames: 5,   // Buffer de 5 frames para salto
 		CoyoteTimeFrames: 6,   // 6 frames de coyote time
+
+		// Registro de eventos
+		EnableEventLog: false, // Se activa con -event-log
+		EventLogDir:    "logs",
 	}
 }
 
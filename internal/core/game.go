@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"image/color"
 	"log"
+	"math/rand"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,11 +18,13 @@ import (
 	"github.com/MarcosBrindis/boss-arena-go/internal/effects"
 	"github.com/MarcosBrindis/boss-arena-go/internal/entities"
 	"github.com/MarcosBrindis/boss-arena-go/internal/input"
+	"github.com/MarcosBrindis/boss-arena-go/internal/physics"
 	"github.com/MarcosBrindis/boss-arena-go/internal/projectiles"
 	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
 	"github.com/MarcosBrindis/boss-arena-go/internal/world"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
@@ -38,15 +43,39 @@ type Game struct {
 	player *entities.Player
 	boss   *entities.Boss
 
+	// Enemigos invocados por el boss y los orbes que sueltan
+	minions         []*entities.Minion
+	orbs            []*entities.StaminaOrb
+	nextMinionIndex int
+
+	// Menú principal y boss rush (rush tiene un solo boss fuera del boss rush)
+	menuIndex int
+	rush      []entities.BossKind
+	rushIndex int
+
 	// Combat System
-	eventSystem   *combat.EventSystem
-	damageCalc    *combat.DamageCalculator
-	effectManager *combat.EffectManager
+	eventSystem    *combat.EventSystem
+	damageCalc     *combat.DamageCalculator
+	damagePipeline *combat.DamagePipeline
+	effectManager  *combat.EffectManager
+	combatLog      *combat.CombatLog
+	lastBreakdown  string              // Desglose del último golpe (debug)
+	eventLogger    *combat.EventLogger // nil si el registro JSONL está desactivado
+
+	// Analítica de la pelea (reporte final)
+	analytics    *FightAnalytics
+	playerAttack trackedAttack // Ataque cuerpo a cuerpo en curso (para detectar fallos)
+	bossAttack   trackedAttack
 
 	// Visual Effects
 	particleSystem *effects.ParticleSystem
 	screenShake    *effects.ScreenShake
 	hitStop        *effects.HitStop
+	slowMotion     *effects.SlowMotion
+
+	// Destello de pantalla (parry)
+	flashFrames   int
+	flashDuration int
 
 	// Audio System
 	soundSystem *audio.SoundSystem
@@ -55,14 +84,22 @@ type Game struct {
 	projectileManager *projectiles.ProjectileManager
 	dodgeSystem       *ai.DodgeSystem
 
+	// Broadphase de proyectiles (se reconstruye cada frame; los buffers se reutilizan)
+	projectileGrid   *physics.Grid
+	projectileBuf    []*projectiles.Projectile
+	projectileSweeps []projectileSweep
+	hostileBuf       []*projectiles.Projectile
+	gridCandidates   []int
+
 	// Contexto (NUEVO - Módulo 7)
 	ctx    context.Context
 	cancel context.CancelFunc
 
 	// Estado del juego
-	state     GameState
-	frame     uint64
-	startTime time.Time
+	state           GameState
+	frame           uint64
+	fightStartFrame uint64 // Frame en que empezó la pelea actual
+	startTime       time.Time
 
 	// Performance tracking
 	updateDuration time.Duration
@@ -81,11 +118,17 @@ type Game struct {
 	escapeKeyPressedLastFrame bool
 	f11KeyPressedLastFrame    bool
 	f3KeyPressedLastFrame     bool
+	menuUpLastFrame           bool
+	menuDownLastFrame         bool
 }
 
-// NewGame crea una nueva instancia del juego
+// NewGame crea una nueva instancia del juego con la configuración por defecto
 func NewGame() *Game {
-	cfg := DefaultConfig()
+	return NewGameWithConfig(DefaultConfig())
+}
+
+// NewGameWithConfig crea una nueva instancia del juego
+func NewGameWithConfig(cfg *Config) *Game {
 
 	// Crear arena
 	arena := world.NewArena(ScreenWidth, ScreenHeight)
@@ -112,6 +155,7 @@ func NewGame() *Game {
 		arena,
 	)
 	boss.SetTarget(player)
+	boss.SetDifficulty(cfg.DifficultyLevel)
 
 	// ========================================================================
 	// CREAR CONTEXTO (Módulo 7)
@@ -122,16 +166,34 @@ func NewGame() *Game {
 	// CREAR SISTEMAS DE COMBATE
 	// ========================================================================
 
-	// Event System (con buffer de 100 eventos)
-	eventSystem := combat.NewEventSystem(100)
+	// Event System (buffer de 100 eventos; el exceso va a un buffer extra
+	// para no perder eventos de las estadísticas)
+	eventConfig := combat.DefaultEventSystemConfig()
+	eventConfig.Policy = combat.OverflowGrow
+	eventSystem := combat.NewEventSystemWithConfig(eventConfig)
 	eventSystem.Start()
 
 	// Damage Calculator
 	damageCalc := combat.NewDamageCalculator()
 
+	// Damage Pipeline (todos los golpes pasan por aquí)
+	damagePipeline := combat.NewDamagePipeline(damageCalc, eventSystem)
+
 	// Effect Manager
 	effectManager := combat.NewEffectManager(50)
 
+	// Combat Log (últimas 8 líneas, visible en modo debug)
+	combatLog := combat.NewCombatLog(8)
+
+	// Event Logger (JSONL por pelea, escribe en su propia goroutine)
+	var eventLogger *combat.EventLogger
+	if cfg.EnableEventLog {
+		logConfig := combat.DefaultEventLoggerConfig()
+		logConfig.Dir = cfg.EventLogDir
+		eventLogger = combat.NewEventLogger(logConfig)
+		eventLogger.Attach(eventSystem)
+	}
+
 	// Particle System
 	particleSystem := effects.NewParticleSystem(200)
 
@@ -141,6 +203,9 @@ func NewGame() *Game {
 	// Hit Stop
 	hitStop := effects.NewHitStop()
 
+	// Slow Motion (esquiva perfecta)
+	slowMotion := effects.NewSlowMotion()
+
 	// Sound System
 	soundSystem := audio.NewSoundSystem()
 
@@ -152,7 +217,8 @@ func NewGame() *Game {
 	projectileManager := projectiles.NewProjectileManager(50, true)
 
 	// Dodge System (IA de esquiva para el boss)
-	dodgeSystem := ai.NewDodgeSystem()
+	dodgeSystem := ai.NewDodgeSystem(ai.DefaultDodgeConfig(), rand.New(rand.NewSource(time.Now().UnixNano())))
+	dodgeSystem.SetDifficulty(cfg.DifficultyLevel)
 
 	game := &Game{
 		config:     cfg,
@@ -165,21 +231,30 @@ func NewGame() *Game {
 		// Combat Systems
 		eventSystem:    eventSystem,
 		damageCalc:     damageCalc,
+		damagePipeline: damagePipeline,
 		effectManager:  effectManager,
+		combatLog:      combatLog,
+		eventLogger:    eventLogger,
+		analytics:      NewFightAnalytics(),
 		particleSystem: particleSystem,
 		screenShake:    screenShake,
 		hitStop:        hitStop,
+		slowMotion:     slowMotion,
 		soundSystem:    soundSystem,
 
 		// Projectile System (NUEVO)
 		projectileManager: projectileManager,
 		dodgeSystem:       dodgeSystem,
+		projectileGrid:    newProjectileGrid(),
 
 		// Context (NUEVO)
 		ctx:    ctx,
 		cancel: cancel,
 
-		state:      StatePlaying,
+		// La pelea empieza al elegir en el menú
+		rush: []entities.BossKind{entities.BossTitan},
+
+		state:      StateMainMenu,
 		startTime:  time.Now(),
 		lastUpdate: time.Now(),
 	}
@@ -216,6 +291,20 @@ func (g *Game) setupEventListeners() {
 		g.soundSystem.PlaySound(audio.SoundHit)
 	})
 
+	// Listener: Combat log con el desglose de daño
+	g.eventSystem.AddListener(combat.EventDamageTaken, func(event combat.CombatEvent) {
+		breakdown, ok := event.Metadata["breakdown"].(combat.DamageBreakdown)
+		if !ok {
+			return
+		}
+		attack, _ := event.Metadata["attack"].(string)
+		g.combatLog.Add(fmt.Sprintf("%s > %s [%s] %s", event.Attacker, event.Target, attack, breakdown))
+	})
+
+	// Listener: Analítica de la pelea
+	g.eventSystem.AddListener(combat.EventDamageTaken, g.analytics.RecordEvent)
+	g.eventSystem.AddListener(combat.EventAttackLanded, g.analytics.RecordEvent)
+
 	// Listener: Cuando aumenta el combo
 	g.eventSystem.AddListener(combat.EventComboIncreased, func(event combat.CombatEvent) {
 		// Efecto visual de combo
@@ -226,13 +315,76 @@ func (g *Game) setupEventListeners() {
 		)
 	})
 
+	// Listener: Cuando se aplica un efecto de estado
+	g.eventSystem.AddListener(combat.EventStatusApplied, func(event combat.CombatEvent) {
+		statusType, ok := event.Metadata["status"].(combat.StatusType)
+		if !ok {
+			return
+		}
+
+		g.effectManager.SpawnEffect(combat.EffectImpact, event.Position, statusType.Color())
+		g.combatLog.Add(fmt.Sprintf("%s +%s", event.Target, statusType))
+
+		if statusType == combat.StatusStun {
+			g.soundSystem.PlaySound(audio.SoundBossRoar)
+		}
+	})
+
+	// Listener: Cuando el jugador hace parry
+	g.eventSystem.AddListener(combat.EventParry, func(event combat.CombatEvent) {
+		g.effectManager.SpawnEffect(combat.EffectExplosion, event.Position, color.RGBA{255, 255, 255, 255})
+		g.particleSystem.Emit(event.Position, 15, color.RGBA{255, 255, 255, 255})
+		g.soundSystem.PlaySound(audio.SoundSlash)
+	})
+
+	// Listener: Cuando el jugador esquiva perfectamente
+	g.eventSystem.AddListener(combat.EventDodge, func(event combat.CombatEvent) {
+		if perfect, _ := event.Metadata["perfect"].(bool); !perfect {
+			return
+		}
+		g.effectManager.SpawnEffect(combat.EffectSlash, event.Position, color.RGBA{255, 215, 0, 255})
+		g.soundSystem.PlaySound(audio.SoundDash)
+	})
+
+	// Listener: Cuando el jugador bloquea
+	g.eventSystem.AddListener(combat.EventBlock, func(event combat.CombatEvent) {
+		g.particleSystem.Emit(event.Position, 4, color.RGBA{120, 120, 255, 255})
+		g.soundSystem.PlaySound(audio.SoundHit)
+	})
+
+	// Listener: Cuando se rompe la postura del boss (ventana de castigo)
+	g.eventSystem.AddListener(combat.EventStagger, func(event combat.CombatEvent) {
+		g.effectManager.SpawnEffect(combat.EffectExplosion, event.Position, color.RGBA{230, 200, 80, 255})
+		g.particleSystem.Emit(event.Position, 20, color.RGBA{230, 200, 80, 255})
+		g.hitStop.Start(6)
+		g.startFlash(6)
+		g.soundSystem.PlaySound(audio.SoundExplosion)
+		g.combatLog.Add(fmt.Sprintf("%s: postura rota", event.Target))
+	})
+
+	// Listener: Cuando un golpe interrumpe al boss
+	g.eventSystem.AddListener(combat.EventFlinch, func(event combat.CombatEvent) {
+		g.effectManager.SpawnEffect(combat.EffectImpact, event.Position, color.RGBA{255, 255, 255, 255})
+		g.hitStop.Start(4)
+		g.soundSystem.PlaySound(audio.SoundHit)
+		g.combatLog.Add(fmt.Sprintf("%s: interrumpido", event.Target))
+	})
+
+	// Listener: Cuando la armadura del boss absorbe un golpe
+	g.eventSystem.AddListener(combat.EventArmorHit, func(event combat.CombatEvent) {
+		g.particleSystem.Emit(event.Position, 4, color.RGBA{255, 215, 0, 255})
+	})
+
 	// Listener: Cuando mata al boss
 	g.eventSystem.AddListener(combat.EventKill, func(event combat.CombatEvent) {
-		if event.Target == "boss" {
+		if event.Target.Kind == combat.ActorKindBoss {
 			// Explosión grande
 			g.particleSystem.Emit(event.Position, 30, color.RGBA{255, 140, 0, 255})
 			g.screenShake.Start(20, 30)
 			g.soundSystem.PlaySound(audio.SoundExplosion)
+		} else if event.Target.Kind == combat.ActorKindMinion {
+			g.particleSystem.Emit(event.Position, 12, color.RGBA{120, 255, 120, 255})
+			g.soundSystem.PlaySound(audio.SoundHit)
 		}
 	})
 }
@@ -251,6 +403,8 @@ func (g *Game) Update() error {
 
 	// Incrementar contador de frames
 	g.frame++
+	g.damagePipeline.SetFrame(g.frame)
+	g.eventSystem.SetFrame(g.frame)
 
 	// Calcular TPS/FPS
 	if g.frame%60 == 0 {
@@ -263,12 +417,24 @@ func (g *Game) Update() error {
 		return err
 	}
 
+	// En el menú no corre la pelea
+	if g.state == StateMainMenu {
+		g.updateMainMenu()
+		g.updateDuration = time.Since(start)
+		return nil
+	}
+
 	// Si está pausado, no actualizar lógica
 	if g.isPaused {
 		g.updateDuration = time.Since(start)
 		return nil
 	}
 
+	// Destello de pantalla (corre incluso durante el hit stop)
+	if g.flashFrames > 0 {
+		g.flashFrames--
+	}
+
 	// ========================================================================
 	// HIT STOP: Si está activo, congelar el juego
 	// ========================================================================
@@ -278,6 +444,15 @@ func (g *Game) Update() error {
 		return nil
 	}
 
+	// ========================================================================
+	// SLOW MOTION: Saltar frames de lógica durante la cámara lenta
+	// ========================================================================
+	g.slowMotion.Update()
+	if g.slowMotion.ShouldSkipFrame() {
+		g.updateDuration = time.Since(start)
+		return nil
+	}
+
 	// ========================================================================
 	// ACTUALIZAR SISTEMAS
 	// ========================================================================
@@ -290,6 +465,18 @@ func (g *Game) Update() error {
 
 	// Actualizar boss
 	g.boss.Update()
+	if g.state == StatePlaying {
+		g.analytics.Sample(g.frame, g.boss.State, g.boss.Phase)
+	}
+
+	// Minions invocados y orbes de stamina
+	g.handleBossSummon()
+	g.updateMinions()
+	g.updateOrbs()
+
+	// Emitir eventos de efectos de estado
+	g.emitStatusEvents(g.player.GetActorID(), g.player.Position, g.player.Status.DrainChanges())
+	g.emitStatusEvents(g.boss.GetActorID(), g.boss.Position, g.boss.Status.DrainChanges())
 
 	// ========================================================================
 	// ACTUALIZAR PROYECTILES (NUEVO - Módulo 7)
@@ -306,19 +493,37 @@ func (g *Game) Update() error {
 	// ========================================================================
 	g.handleBossShooting()
 
+	// Ondas de choque del slam
+	g.handleBossShockwave()
+
+	// Impacto al caer del leap
+	g.handleBossLeapImpact()
+
+	// Aviso sonoro de los ataques del boss
+	g.handleBossTelegraph()
+
 	// ========================================================================
 	// VERIFICAR IA DE ESQUIVA DEL BOSS (NUEVO - Módulo 7)
 	// ========================================================================
 	g.updateBossDodge()
 
-	// Detectar colisiones jugador-boss
+	// Detectar colisiones jugador-boss y jugador-minions
 	g.checkPlayerBossCollisions()
+	g.checkMinionCollisions()
 
 	// ========================================================================
 	// DETECTAR COLISIONES DE PROYECTILES (NUEVO - Módulo 7)
 	// ========================================================================
 	g.checkProjectileCollisions()
 
+	// Ataques que terminaron sin conectar (precisión)
+	g.finishAttacks()
+
+	// ========================================================================
+	// DESPACHAR EVENTOS DE COMBATE (listeners en el hilo del juego)
+	// ========================================================================
+	g.eventSystem.Dispatch()
+
 	// Actualizar efectos visuales
 	g.effectManager.Update()
 	g.particleSystem.Update()
@@ -326,8 +531,6 @@ func (g *Game) Update() error {
 
 	// Actualizar según el estado actual
 	switch g.state {
-	case StateMainMenu:
-		g.updateMainMenu()
 	case StatePlaying:
 		g.updatePlaying()
 	case StatePaused:
@@ -373,6 +576,14 @@ func (g *Game) Draw(screen *ebiten.Image) {
 		g.drawVictory(tempScreen)
 	}
 
+	// Tinte de cámara lenta
+	if g.slowMotion.IsActive() {
+		vector.DrawFilledRect(tempScreen, 0, 0, ScreenWidth, ScreenHeight, color.RGBA{0, 40, 120, 50}, false)
+	}
+
+	// Destello de pantalla
+	g.drawFlash(tempScreen)
+
 	// Dibujar información de debug
 	if g.config.ShowDebugInfo {
 		g.drawDebugInfo(tempScreen)
@@ -396,39 +607,70 @@ func (g *Game) RestartGame() {
 	g.player.State = entities.StateIdle
 	g.player.CanDash = true
 	g.player.JumpCount = 0
-
-	// Resetear boss
-	g.boss.Position = utils.NewVector2(1000, 300)
-	g.boss.Velocity = utils.Zero()
-	g.boss.Health = g.boss.MaxHealth
-	g.boss.State = entities.BossStateIdle
-	g.boss.Phase = entities.Phase1
-	g.boss.IsInvulnerable = false
-	g.boss.ConsecutivePogos = 0
-
-	// Resetear cooldowns del boss
-	g.boss.AttackCooldown = 0
-	g.boss.SlamCooldown = 0
-	g.boss.ChargeCooldown = 0
-	g.boss.RoarCooldown = 0
-	g.boss.ShootCooldown = 0
-
-	// Actualizar colores del boss según fase 1
-	g.boss.UpdateColor()
+	g.player.Status.Clear()
+	g.player.GuardFrames = 0
+	g.player.Attack.Stop()
+	g.player.InvulnTimeLeft = 0
+	g.player.DamageBuffTimeLeft = 0
+
+	// Boss nuevo: el primero de la partida (o del boss rush)
+	g.rushIndex = 0
+	g.spawnBoss(g.rush[g.rushIndex])
+	g.clearMinions()
+	g.dodgeSystem.Reset()
 
 	// Limpiar efectos
 	g.particleSystem.Clear()
 	g.effectManager.Clear()
 	g.screenShake.Stop()
+	g.slowMotion.Stop()
+	g.flashFrames = 0
 
 	// Limpiar proyectiles (NUEVO)
 	g.projectileManager.Clear()
 
-	// Resetear estadísticas
+	// Resetear estadísticas y registro de golpes
 	g.eventSystem.ResetStats()
+	g.damagePipeline.Reset()
+	g.combatLog.Clear()
+	g.lastBreakdown = ""
+	g.playerAttack = trackedAttack{}
+	g.bossAttack = trackedAttack{}
 
 	// Volver a estado jugando
 	g.state = StatePlaying
+	g.startFight()
+}
+
+// startFight marca el inicio de una pelea (abre un archivo nuevo en el event log)
+func (g *Game) startFight() {
+	g.fightStartFrame = g.frame
+	g.analytics.Start(g.frame)
+	g.eventSystem.EmitEvent(combat.CombatEvent{
+		Type:     combat.EventFightStarted,
+		Attacker: g.player.GetActorID(),
+		Target:   g.boss.GetActorID(),
+		Metadata: map[string]interface{}{
+			"player_hp": g.player.Health,
+			"boss_hp":   g.boss.Health,
+		},
+	})
+}
+
+// endFight marca el final de una pelea ("victory" o "defeat")
+func (g *Game) endFight(result string) {
+	g.analytics.Finish(g.frame)
+	g.eventSystem.EmitEvent(combat.CombatEvent{
+		Type:     combat.EventFightEnded,
+		Attacker: g.player.GetActorID(),
+		Target:   g.boss.GetActorID(),
+		Metadata: map[string]interface{}{
+			"result":    result,
+			"player_hp": g.player.Health,
+			"boss_hp":   g.boss.Health,
+			"frames":    g.frame - g.fightStartFrame,
+		},
+	})
 }
 
 // Cleanup limpia recursos al cerrar el juego
@@ -455,6 +697,11 @@ func (g *Game) Cleanup() {
 	// Esperar que todas las goroutines terminen
 	wg.Wait()
 
+	// Cerrar el event log al final (recibe los últimos eventos del Event System)
+	if g.eventLogger != nil {
+		g.eventLogger.Close()
+	}
+
 	log.Println("✅ Todas las goroutines cerradas correctamente")
 }
 
@@ -484,27 +731,39 @@ func (g *Game) handleGlobalInput() error {
 	return nil
 }
 
-func (g *Game) updateMainMenu() {
-	// TODO
-}
-
 func (g *Game) updatePlaying() {
 	// Verificar victoria
 	if g.boss.State == entities.BossStateDead && g.state != StateVictory {
-		g.state = StateVictory
-
 		// Emitir evento de victoria
 		g.eventSystem.EmitEvent(combat.CombatEvent{
 			Type:     combat.EventKill,
-			Target:   "boss",
-			Attacker: "player",
+			Target:   g.boss.GetActorID(),
+			Attacker: g.player.GetActorID(),
 			Position: g.boss.Position,
 		})
+		g.endFight("victory")
+
+		// Sin el boss, sus minions se desvanecen
+		g.clearMinions()
+
+		// En el boss rush entra el siguiente; si era el último, victoria
+		if !g.advanceRush() {
+			g.state = StateVictory
+		}
 	}
 
 	// Verificar derrota
 	if g.player.State == entities.StateDead && g.state != StateGameOver {
 		g.state = StateGameOver
+
+		// Emitir evento de derrota
+		g.eventSystem.EmitEvent(combat.CombatEvent{
+			Type:     combat.EventKill,
+			Target:   g.player.GetActorID(),
+			Attacker: g.boss.GetActorID(),
+			Position: g.player.Position,
+		})
+		g.endFight("defeat")
 	}
 }
 
@@ -517,6 +776,11 @@ func (g *Game) updateGameOver() {
 	if ebiten.IsKeyPressed(ebiten.KeyR) || g.controller.IsSpecialPressed() {
 		g.RestartGame()
 	}
+
+	// Volver al menú con M (solo al pulsar: mantenerla no atraviesa el menú)
+	if inpututil.IsKeyJustPressed(ebiten.KeyM) {
+		g.state = StateMainMenu
+	}
 }
 
 func (g *Game) updateVictory() {
@@ -524,6 +788,56 @@ func (g *Game) updateVictory() {
 	if ebiten.IsKeyPressed(ebiten.KeyR) || g.controller.IsSpecialPressed() {
 		g.RestartGame()
 	}
+
+	// Volver al menú con M (solo al pulsar: mantenerla no atraviesa el menú)
+	if inpututil.IsKeyJustPressed(ebiten.KeyM) {
+		g.state = StateMainMenu
+	}
+}
+
+// ============================================================================
+// EFECTOS DE ESTADO
+// ============================================================================
+
+// emitStatusEvents convierte los cambios de estado de una entidad en eventos de combate
+func (g *Game) emitStatusEvents(target combat.ActorID, position utils.Vector2, changes []combat.StatusChange) {
+	for _, change := range changes {
+		metadata := map[string]interface{}{
+			"status": change.Type,
+			"stacks": change.Stacks,
+		}
+
+		switch change.Kind {
+		case combat.StatusChangeApplied:
+			g.eventSystem.EmitEvent(combat.CombatEvent{
+				Type:     combat.EventStatusApplied,
+				Position: position,
+				Target:   target,
+				Metadata: metadata,
+			})
+
+		case combat.StatusChangeExpired:
+			g.eventSystem.EmitEvent(combat.CombatEvent{
+				Type:     combat.EventStatusExpired,
+				Position: position,
+				Target:   target,
+				Metadata: metadata,
+			})
+
+		case combat.StatusChangeTick:
+			// Solo el daño por tiempo cuenta como daño recibido
+			if change.Type != combat.StatusBurn {
+				continue
+			}
+			g.eventSystem.EmitEvent(combat.CombatEvent{
+				Type:     combat.EventDamageTaken,
+				Damage:   change.Amount,
+				Position: position,
+				Target:   target,
+				Metadata: metadata,
+			})
+		}
+	}
 }
 
 // ============================================================================
@@ -552,8 +866,14 @@ func (g *Game) handlePlayerShooting() {
 		projType = projectiles.ProjectilePlayerBasic
 	}
 
-	// Crear proyectil
-	g.projectileManager.Spawn(projType, shootPos, shootDir, "player")
+	// Disparar desde lejos es un hábito que el boss aprende a castigar
+	if utils.Abs(g.player.Position.X-g.boss.Position.X) > 300 {
+		g.boss.ObserveHabit(ai.HabitRangedShots, 1)
+	}
+
+	// Crear proyectil (con el buff de esquiva perfecta)
+	proj := g.projectileManager.Spawn(projType, shootPos, shootDir, g.player.GetActorID())
+	proj.Damage = int(float64(proj.Damage) * g.player.GetDamageMultiplier())
 
 	// Sonido
 	g.soundSystem.PlaySound(audio.SoundSlash)
@@ -562,6 +882,16 @@ func (g *Game) handlePlayerShooting() {
 	g.particleSystem.Emit(shootPos, 3, color.RGBA{0, 200, 255, 255})
 }
 
+// handleBossTelegraph reproduce el sonido del aviso cuando el boss empieza uno
+func (g *Game) handleBossTelegraph() {
+	if !g.boss.WantsTelegraphSound {
+		return
+	}
+	g.boss.WantsTelegraphSound = false
+
+	g.soundSystem.PlaySound(g.boss.GetTelegraphSound())
+}
+
 // handleBossShooting maneja el disparo del boss
 func (g *Game) handleBossShooting() {
 	if !g.boss.WantsToShoot {
@@ -590,13 +920,13 @@ func (g *Game) handleBossShooting() {
 		projType = projectiles.ProjectileBossMissile
 
 		// Crear proyectil con target
-		proj := g.projectileManager.Spawn(projType, shootPos, shootDir, "boss")
+		proj := g.projectileManager.Spawn(projType, shootPos, shootDir, g.boss.GetActorID())
 		proj.Target = &g.player.Position
 
 	} else {
 		// Fireball normal (Fase 2)
 		projType = projectiles.ProjectileBossFireball
-		g.projectileManager.Spawn(projType, shootPos, shootDir, "boss")
+		g.projectileManager.Spawn(projType, shootPos, shootDir, g.boss.GetActorID())
 	}
 
 	// Sonido
@@ -609,6 +939,54 @@ func (g *Game) handleBossShooting() {
 	g.screenShake.Start(3, 5)
 }
 
+// handleBossShockwave lanza las ondas de choque del slam en el frame de impacto
+func (g *Game) handleBossShockwave() {
+	if !g.boss.WantsShockwave {
+		return
+	}
+	g.boss.WantsShockwave = false
+
+	speed := g.boss.GetShockwaveSpeed()
+	groundY := g.boss.GetGroundY()
+
+	// Una onda hacia cada lado, saliendo de los bordes del boss
+	for _, dir := range []utils.Vector2{utils.Left(), utils.Right()} {
+		proj := g.projectileManager.Spawn(projectiles.ProjectileBossShockwave, g.boss.Position, dir, g.boss.GetActorID())
+
+		// Pegada al suelo
+		proj.Position = utils.NewVector2(
+			g.boss.Position.X+dir.X*g.boss.Size.X/2,
+			groundY-proj.Size.Y/2-1,
+		)
+		proj.Speed = speed
+		proj.Velocity = dir.Mul(speed)
+		proj.Damage = g.boss.GetShockwaveDamage()
+		proj.DamageType = g.boss.GetShockwaveDamageType()
+		proj.Lifetime = g.boss.GetShockwaveLifetime()
+		proj.SplitAfter = g.boss.GetShockwaveSplitFrames()
+	}
+
+	// Efectos de impacto
+	g.particleSystem.Emit(utils.NewVector2(g.boss.Position.X, groundY), 15, color.RGBA{255, 180, 60, 255})
+	g.screenShake.Start(8, 15)
+	g.soundSystem.PlaySound(audio.SoundExplosion)
+	g.controller.Vibrate(200, 0.5)
+}
+
+// handleBossLeapImpact aplica los efectos del aterrizaje del leap
+func (g *Game) handleBossLeapImpact() {
+	if !g.boss.WantsLeapImpact {
+		return
+	}
+	g.boss.WantsLeapImpact = false
+
+	groundY := g.boss.GetGroundY()
+	g.particleSystem.Emit(utils.NewVector2(g.boss.Position.X, groundY), 12, color.RGBA{180, 80, 255, 255})
+	g.screenShake.Start(6, 12)
+	g.soundSystem.PlaySound(audio.SoundExplosion)
+	g.controller.Vibrate(150, 0.4)
+}
+
 // updateBossDodge actualiza la IA de esquiva del boss
 func (g *Game) updateBossDodge() {
 	// Solo en Fase 2 y 3
@@ -616,101 +994,207 @@ func (g *Game) updateBossDodge() {
 		return
 	}
 
-	// Obtener proyectiles del jugador
-	playerProjectiles := g.projectileManager.GetProjectilesByOwner("player")
+	// Obtener proyectiles que pueden dañar al boss
+	g.hostileBuf = g.projectileManager.GetProjectilesHostileTo(g.boss.GetActorID(), g.hostileBuf[:0])
 
-	// Verificar si debe esquivar
-	shouldDodge, dodgeDir := g.dodgeSystem.ShouldDodge(g.boss.Position, playerProjectiles)
+	// Predecir impactos y elegir reacción (paso, salto o aguantar)
+	dodgeCtx := g.boss.GetDodgeContext()
+	decision := g.dodgeSystem.Update(&dodgeCtx, g.hostileBuf)
+	g.boss.Dodge(decision)
+}
 
-	if shouldDodge && g.boss.IsOnGround {
-		// Aplicar velocidad de esquiva
-		g.boss.Velocity.X += dodgeDir.X
+// projectileSweep es el recorrido de un proyectil en el frame
+type projectileSweep struct {
+	start   utils.Rectangle
+	delta   utils.Vector2
+	wallHit utils.SweepResult
+	reach   float64 // Fracción del recorrido antes de chocar con la arena
+}
 
-		// Limitar velocidad máxima
-		maxDodgeSpeed := 8.0
-		if utils.Abs(g.boss.Velocity.X) > maxDodgeSpeed {
-			if g.boss.Velocity.X > 0 {
-				g.boss.Velocity.X = maxDodgeSpeed
-			} else {
-				g.boss.Velocity.X = -maxDodgeSpeed
-			}
-		}
-	}
+// gridMargin deja que los proyectiles que salen un poco de pantalla sigan en su celda
+const gridMargin = 50.0
+
+// newProjectileGrid crea la grilla de proyectiles que cubre la pantalla
+func newProjectileGrid() *physics.Grid {
+	bounds := utils.NewRectangle(-gridMargin, -gridMargin, ScreenWidth+2*gridMargin, ScreenHeight+2*gridMargin)
+	return physics.NewGrid(bounds, physics.DefaultCellSize)
 }
 
-// checkProjectileCollisions verifica colisiones de proyectiles
+// checkProjectileCollisions verifica colisiones de proyectiles.
+// Broadphase: cada proyectil entra en la grilla con su recorrido del frame y
+// cada objetivo solo prueba los que comparten celda con su hurtbox; el barrido
+// exacto (narrowphase) se hace solo con esos candidatos.
 func (g *Game) checkProjectileCollisions() {
-	projectiles := g.projectileManager.GetActiveProjectiles()
+	g.projectileBuf = g.projectileManager.GetActiveProjectiles(g.projectileBuf[:0])
+	g.buildProjectileGrid()
 
-	for _, proj := range projectiles {
+	// Proyectiles hostiles al Boss (las facciones deciden el fuego amigo)
+	g.gridCandidates = g.queryProjectiles(g.boss.GetHurtbox(), g.boss.GetActorID())
+	for _, i := range g.gridCandidates {
+		proj, sweep := g.projectileBuf[i], &g.projectileSweeps[i]
 		if !proj.IsActive {
 			continue
 		}
 
-		// Colisión con arena (paredes/suelo)
-		projHitbox := proj.GetHitbox()
-		collides, _ := g.arena.CheckCollision(projHitbox)
-		if collides {
-			proj.IsActive = false
-			// Efecto de impacto en pared
-			g.particleSystem.Emit(proj.Position, 3, proj.Color)
+		// Colisión continua: recorrido completo del frame, no solo la posición final
+		// (un disparo rápido puede saltarse un hurtbox delgado entre dos frames).
+		// Solo cuentan los impactos anteriores al choque con la arena.
+		hit := sweep.start.Sweep(sweep.delta, g.boss.GetHurtbox())
+		if !hit.Hit || hit.Time > sweep.reach {
 			continue
 		}
 
-		// Proyectiles del jugador vs Boss
-		if proj.Owner == "player" {
-			bossHurtbox := g.boss.GetHurtbox()
-			if projHitbox.Intersects(bossHurtbox) {
-				// Aplicar daño
-				if g.boss.TakeDamage(proj.Damage) {
-					// Efectos
-					g.particleSystem.Emit(proj.Position, 8, color.RGBA{255, 100, 100, 255})
-					g.screenShake.Start(float64(proj.Damage)/10.0, 5)
-					g.controller.Vibrate(100, 0.4)
-
-					// Evento
-					g.eventSystem.EmitEvent(combat.CombatEvent{
-						Type:     combat.EventDamageDealt,
-						Damage:   proj.Damage,
-						Position: proj.Position,
-						Attacker: "player",
-						Target:   "boss",
-					})
-				}
+		impact := proj.ImpactPoint(hit.Time)
+		outcome := g.damagePipeline.Process(combat.Hit{
+			AttackID:      proj.AttackID,
+			AttackName:    proj.Type.String(),
+			Attacker:      proj.Owner,
+			BaseDamage:    proj.Damage,
+			DamageType:    proj.DamageType,
+			BaseKnockback: 2,
+			Direction:     proj.Velocity,
+			Source:        impact,
+			Position:      impact,
+			Ranged:        true,
+			PoiseDamage:   proj.PoiseDamage,
+			// El disparo cargado rompe la guardia e interrumpe al boss
+			GuardBreaker: proj.Type == projectiles.ProjectilePlayerCharged,
+			Interrupts:   proj.Type == projectiles.ProjectilePlayerCharged,
+		}, g.boss)
+
+		g.recordBreakdown(outcome)
+		if outcome.Connected() {
+			g.particleSystem.Emit(impact, 8, color.RGBA{255, 100, 100, 255})
+			g.controller.Vibrate(100, 0.4)
+		}
 
-				// Desactivar proyectil
-				proj.IsActive = false
-			}
+		// Desactivar proyectil
+		proj.IsActive = false
+	}
+
+	// Proyectiles hostiles a los minions
+	g.checkProjectilesHitMinions()
+
+	// Proyectiles hostiles al Jugador
+	g.gridCandidates = g.queryProjectiles(g.player.GetHurtbox(), g.player.GetActorID())
+	for _, i := range g.gridCandidates {
+		proj, sweep := g.projectileBuf[i], &g.projectileSweeps[i]
+		if !proj.IsActive {
+			continue
+		}
+
+		hit := sweep.start.Sweep(sweep.delta, g.player.GetHurtbox())
+		if !hit.Hit || hit.Time > sweep.reach {
+			continue
 		}
 
-		// Proyectiles del boss vs Jugador
-		if proj.Owner == "boss" {
-			playerHurtbox := g.player.GetHurtbox()
-			if projHitbox.Intersects(playerHurtbox) {
-				// Calcular knockback
-				direction := proj.Velocity.Normalize()
-				knockback := direction.Mul(10)
-
-				// Aplicar daño
-				g.player.TakeDamage(proj.Damage, knockback)
-
-				// Efectos
-				g.particleSystem.Emit(proj.Position, 8, color.RGBA{255, 0, 0, 255})
-				g.screenShake.Start(float64(proj.Damage)/10.0, 5)
-
-				// Evento
-				g.eventSystem.EmitEvent(combat.CombatEvent{
-					Type:     combat.EventDamageDealt,
-					Damage:   proj.Damage,
-					Position: proj.Position,
-					Attacker: "boss",
-					Target:   "player",
-				})
-
-				// Desactivar proyectil
-				proj.IsActive = false
+		impact := proj.ImpactPoint(hit.Time)
+		outcome := g.hitPlayer(combat.Hit{
+			AttackID:      proj.AttackID,
+			AttackName:    proj.Type.String(),
+			Attacker:      proj.Owner,
+			BaseDamage:    proj.Damage,
+			DamageType:    proj.DamageType,
+			BaseKnockback: 10,
+			Direction:     proj.Velocity,
+			Source:        impact,
+			Position:      impact,
+			// Solo las bolas de fuego se pueden reflejar con parry
+			Parryable: proj.Type == projectiles.ProjectileBossFireball,
+			Ranged:    true,
+			Inflicts:  inflictsFor(proj.DamageType),
+		})
+
+		switch outcome.Result {
+		case combat.HitParried:
+			g.reflectProjectile(proj)
+
+		case combat.HitBlocked, combat.HitLanded:
+			g.particleSystem.Emit(impact, 8, color.RGBA{255, 0, 0, 255})
+			proj.IsActive = false
+		}
+		// Esquivado o ya registrado: el proyectil sigue su camino
+	}
+
+	// Colisión con arena (paredes/suelo): el impacto queda donde tocó
+	for i, proj := range g.projectileBuf {
+		wallHit := g.projectileSweeps[i].wallHit
+		if proj.IsActive && wallHit.Hit {
+			proj.IsActive = false
+			proj.Position = proj.ImpactPoint(wallHit.Time)
+			g.particleSystem.Emit(proj.Position, 3, proj.Color)
+		}
+	}
+}
+
+// buildProjectileGrid calcula el recorrido de cada proyectil activo y lo mete en la grilla
+func (g *Game) buildProjectileGrid() {
+	g.projectileGrid.Clear()
+	g.projectileSweeps = g.projectileSweeps[:0]
+
+	for i, proj := range g.projectileBuf {
+		start, delta := proj.GetSweep()
+		sweep := projectileSweep{start: start, delta: delta, reach: 1.0}
+		if proj.IsActive {
+			sweep.wallHit = g.arena.Sweep(start, delta)
+			if sweep.wallHit.Hit {
+				sweep.reach = sweep.wallHit.Time
 			}
+			g.projectileGrid.Insert(start.SweptBounds(delta), proj.Owner, i)
+		}
+		g.projectileSweeps = append(g.projectileSweeps, sweep)
+	}
+}
+
+// queryProjectiles retorna (en orden de spawn) los proyectiles que pueden
+// dañar a target y cuyo recorrido toca su hurtbox. Reutiliza g.gridCandidates.
+func (g *Game) queryProjectiles(hurtbox utils.Rectangle, target combat.ActorID) []int {
+	candidates := g.projectileGrid.QueryHostileTo(hurtbox, target, g.gridCandidates[:0])
+	// Mismo orden que recorrer la lista: el resultado no depende de las celdas
+	slices.Sort(candidates)
+	return candidates
+}
+
+// ============================================================================
+// FIN DE ATAQUES (PRECISIÓN)
+// ============================================================================
+
+// trackedAttack es la instancia de ataque cuerpo a cuerpo que se está siguiendo
+type trackedAttack struct {
+	id       uint64
+	name     string
+	attacker combat.ActorID
+	active   bool // Llegó a tener hitbox (si se cancela antes, no cuenta como fallo)
+}
+
+// finishAttacks avisa al pipeline de los ataques que terminaron este frame.
+// Los que no hicieron daño a nadie se emiten como EventAttackMissed.
+func (g *Game) finishAttacks() {
+	g.trackAttack(&g.playerAttack, &g.player.Attack, g.player.GetActorID(), g.player.Position)
+	g.trackAttack(&g.bossAttack, &g.boss.Attack, g.boss.GetActorID(), g.boss.Position)
+
+	for _, finished := range g.projectileManager.DrainFinished() {
+		g.damagePipeline.FinishAttack(finished.AttackID, finished.Type.String(), finished.Owner, finished.Position)
+	}
+}
+
+// trackAttack compara el ataque en curso con el seguido y cierra el anterior si cambió
+func (g *Game) trackAttack(tracked *trackedAttack, attack *combat.AttackState, attacker combat.ActorID, position utils.Vector2) {
+	if tracked.id != 0 && (!attack.IsRunning() || attack.ID != tracked.id) {
+		if tracked.active {
+			g.damagePipeline.FinishAttack(tracked.id, tracked.name, tracked.attacker, position)
 		}
+		*tracked = trackedAttack{}
+	}
+
+	if !attack.IsRunning() {
+		return
+	}
+	if tracked.id == 0 {
+		*tracked = trackedAttack{id: attack.ID, name: attack.Def.Name, attacker: attacker}
+	}
+	if attack.Phase() == combat.AttackPhaseActive {
+		tracked.active = true
 	}
 }
 
@@ -734,51 +1218,61 @@ func (g *Game) checkPlayerBossCollisions() {
 // checkPlayerAttacksBoss verifica si el jugador está atacando al boss
 func (g *Game) checkPlayerAttacksBoss() {
 	bossHurtbox := g.boss.GetHurtbox()
+	direction := g.boss.Position.Sub(g.player.Position)
 
 	// Ataque normal
+	attack := g.player.GetCurrentAttack()
+
 	attackHitbox := g.player.GetAttackHitbox()
 	if attackHitbox != nil && attackHitbox.Intersects(bossHurtbox) {
-		baseDamage := g.player.GetAttackDamage()
-
-		// Calcular daño con sistema nuevo
-		isCritical := g.damageCalc.RollCritical(0.15)
-		comboMultiplier := 1.0 + float64(g.player.ComboCount)*0.1
-		damage := g.damageCalc.CalculateDamage(baseDamage, combat.DamagePhysical, isCritical, comboMultiplier)
-
-		if g.boss.TakeDamage(damage) {
+		outcome := g.damagePipeline.Process(combat.Hit{
+			AttackID:        g.player.Attack.ID,
+			AttackName:      attack.Name,
+			Attacker:        g.player.GetActorID(),
+			BaseDamage:      g.player.GetAttackDamage(),
+			DamageType:      attack.DamageType,
+			CritChance:      attack.CritChance,
+			ComboCount:      g.player.ComboCount,
+			ComboMultiplier: 1.0 + float64(g.player.ComboCount)*0.1,
+			BaseKnockback:   attack.Knockback,
+			PoiseDamage:     attack.PoiseDamage,
+			Interrupts:      attack.Interrupts,
+			Direction:       direction,
+			Source:          g.player.Position,
+			Position:        g.boss.Position,
+		}, g.boss)
+
+		g.recordBreakdown(outcome)
+		if outcome.Connected() {
 			g.controller.Vibrate(100, 0.5)
-
-			// Emitir evento de daño
-			g.eventSystem.EmitEvent(combat.CombatEvent{
-				Type:       combat.EventDamageDealt,
-				Damage:     damage,
-				Position:   g.boss.Position,
-				Attacker:   "player",
-				Target:     "boss",
-				IsCritical: isCritical,
-				ComboCount: g.player.ComboCount,
-			})
 		}
 	}
 
 	// Down Air Attack
 	downAirHitbox := g.player.GetDownAirAttackHitbox()
 	if downAirHitbox != nil && downAirHitbox.Intersects(bossHurtbox) {
-		baseDamage := g.player.GetDownAirAttackDamage()
-
-		// Calcular daño
-		isCritical := g.damageCalc.RollCritical(0.25)
-		damage := g.damageCalc.CalculateDamage(baseDamage, combat.DamagePhysical, isCritical, 1.0)
-
-		if g.boss.TakeDamage(damage) {
+		outcome := g.damagePipeline.Process(combat.Hit{
+			AttackID:      g.player.Attack.ID,
+			AttackName:    attack.Name,
+			Attacker:      g.player.GetActorID(),
+			BaseDamage:    g.player.GetDownAirAttackDamage(),
+			DamageType:    attack.DamageType,
+			CritChance:    attack.CritChance,
+			BaseKnockback: attack.Knockback,
+			PoiseDamage:   attack.PoiseDamage,
+			Interrupts:    attack.Interrupts,
+			Direction:     direction,
+			Source:        g.player.Position,
+			Position:      g.boss.Position,
+		}, g.boss)
+
+		g.recordBreakdown(outcome)
+		if outcome.Connected() {
 			// Feedback más fuerte
 			g.controller.Vibrate(150, 0.6)
 
 			// POGO EFFECT MEJORADO
-			g.player.Velocity.Y = -13
-			g.player.State = entities.StateJumping
-			g.player.AttackTimeLeft = 0
-			g.player.JumpCount = 1
+			g.player.PogoBounce()
 
 			// Recuperar stamina
 			g.player.Stamina += 10
@@ -788,24 +1282,12 @@ func (g *Game) checkPlayerAttacksBoss() {
 
 			// Contador de pogos consecutivos
 			g.boss.ConsecutivePogos++
+			g.boss.ObserveHabit(ai.HabitPogo, 1)
 
+			// Tres pogos seguidos: el boss responde con su movimiento anti-pogo
 			if g.boss.ConsecutivePogos >= 3 {
-				if g.boss.SlamCooldown == 0 && g.boss.IsOnGround {
-					g.boss.NextAction = entities.BossStateSlam
-					g.boss.DecisionTimer = 0
-				}
-				g.boss.ConsecutivePogos = 0
+				g.boss.OnPogoStreak()
 			}
-
-			// Emitir evento
-			g.eventSystem.EmitEvent(combat.CombatEvent{
-				Type:       combat.EventDamageDealt,
-				Damage:     damage,
-				Position:   g.boss.Position,
-				Attacker:   "player",
-				Target:     "boss",
-				IsCritical: isCritical,
-			})
 		}
 	}
 }
@@ -813,102 +1295,151 @@ func (g *Game) checkPlayerAttacksBoss() {
 // checkBossAttacksPlayer verifica si el boss está atacando al jugador
 func (g *Game) checkBossAttacksPlayer() {
 	playerHurtbox := g.player.GetHurtbox()
+	direction := g.player.Position.Sub(g.boss.Position)
+
+	// Ataque básico, slam y charge (frame data en la definición de cada ataque)
+	if attack := g.boss.GetCurrentAttack(); attack != nil {
+		var hitbox *utils.Rectangle
+		switch g.boss.State {
+		case entities.BossStateAttacking:
+			hitbox = g.boss.GetAttackHitbox()
+		case entities.BossStateSlam:
+			hitbox = g.boss.GetSlamHitbox()
+		case entities.BossStateLeap:
+			hitbox = g.boss.GetLeapHitbox()
+		case entities.BossStateCounter:
+			hitbox = g.boss.GetCounterHitbox()
+		case entities.BossStateCharge:
+			hitbox = g.boss.GetChargeHitbox()
+			direction = g.boss.ChargeDirection
+		case entities.BossStateSwoop:
+			hitbox = g.boss.GetSwoopHitbox()
+			direction = g.boss.GetSwoopDirection()
+		}
 
-	// Verificar ataque básico
-	attackHitbox := g.boss.GetAttackHitbox()
-	if attackHitbox != nil && attackHitbox.Intersects(playerHurtbox) {
-		direction := g.player.Position.Sub(g.boss.Position).Normalize()
-		knockback := direction.Mul(8)
-
-		g.player.TakeDamage(g.boss.Damage, knockback)
+		if hitbox != nil && hitbox.Intersects(playerHurtbox) {
+			outcome := g.hitPlayer(g.newBossHit(attack, direction))
+			switch outcome.Result {
+			case combat.HitParried:
+				g.boss.OnParried()
 
-		// Emitir evento
-		g.eventSystem.EmitEvent(combat.CombatEvent{
-			Type:     combat.EventDamageDealt,
-			Damage:   g.boss.Damage,
-			Position: g.player.Position,
-			Attacker: "boss",
-			Target:   "player",
-		})
+			case combat.HitEvaded, combat.HitPerfectDodge:
+				// Una carga por instancia: el pipeline solo reporta la esquiva una vez
+				if g.boss.State == entities.BossStateCharge || g.boss.State == entities.BossStateSwoop {
+					g.boss.ObserveHabit(ai.HabitDashThrough, 2.5)
+				}
+			}
+		}
 	}
 
-	// Verificar Slam
-	slamHitbox := g.boss.GetSlamHitbox()
-	if slamHitbox != nil && slamHitbox.Intersects(playerHurtbox) {
-		direction := g.player.Position.Sub(g.boss.Position).Normalize()
-		knockback := direction.Mul(12)
+	// Verificar contacto
+	if g.boss.State != entities.BossStateDead &&
+		g.boss.State != entities.BossStateStunned &&
+		g.boss.State != entities.BossStateTransition {
 
-		g.player.TakeDamage(g.boss.Damage*2, knockback)
+		bossHitbox := g.boss.GetHitbox()
 
-		// Emitir evento
-		g.eventSystem.EmitEvent(combat.CombatEvent{
-			Type:     combat.EventDamageDealt,
-			Damage:   g.boss.Damage * 2,
-			Position: g.player.Position,
-			Attacker: "boss",
-			Target:   "player",
-		})
+		if bossHitbox.Intersects(playerHurtbox) {
+			// Daño continuo: sin registro (la invulnerabilidad post-golpe lo limita)
+			// y atravesar el cuerpo del boss no cuenta como esquiva perfecta
+			g.hitPlayer(combat.Hit{
+				AttackName:    "boss_contact",
+				Attacker:      g.boss.GetActorID(),
+				BaseDamage:    5,
+				DamageType:    combat.DamagePhysical,
+				BaseKnockback: 6,
+				Direction:     direction,
+				Source:        g.boss.Position,
+				Position:      g.player.Position,
+				Contact:       true,
+			})
+		}
 	}
+}
 
-	// Verificar Charge
-	chargeHitbox := g.boss.GetChargeHitbox()
-	if chargeHitbox != nil && chargeHitbox.Intersects(playerHurtbox) {
-		direction := g.boss.ChargeDirection
-		knockback := direction.Mul(8)
+// newBossHit construye un golpe cuerpo a cuerpo del ataque actual del boss
+func (g *Game) newBossHit(attack *combat.AttackDefinition, direction utils.Vector2) combat.Hit {
+	return combat.Hit{
+		AttackID:      g.boss.Attack.ID,
+		AttackName:    attack.Name,
+		Attacker:      g.boss.GetActorID(),
+		BaseDamage:    attack.Damage,
+		DamageType:    attack.DamageType,
+		CritChance:    attack.CritChance,
+		BaseKnockback: attack.Knockback,
+		Direction:     direction,
+		Source:        g.boss.Position,
+		Position:      g.player.Position,
+		Parryable:     attack.Parryable,
+	}
+}
 
-		g.player.TakeDamage(g.boss.Damage*2, knockback)
+// hitPlayer pasa un golpe del boss por el pipeline y aplica las reacciones
+// del juego (cámara lenta en esquiva perfecta, hit stop y destello en parry)
+func (g *Game) hitPlayer(hit combat.Hit) combat.HitOutcome {
+	outcome := g.damagePipeline.Process(hit, g.player)
+	g.recordBreakdown(outcome)
 
-		// Emitir evento
-		g.eventSystem.EmitEvent(combat.CombatEvent{
-			Type:     combat.EventDamageDealt,
-			Damage:   g.boss.Damage * 2,
-			Position: g.player.Position,
-			Attacker: "boss",
-			Target:   "player",
-		})
+	// Las cadenas del boss cambian de rama según si el golpe entró limpio
+	if outcome.Result == combat.HitLanded && hit.Attacker == g.boss.GetActorID() {
+		g.boss.OnHitLanded()
 	}
 
-	// Verificar contacto
-	if g.boss.State != entities.BossStateDead &&
-		g.boss.State != entities.BossStateStunned &&
-		g.boss.State != entities.BossStateTransition {
+	switch outcome.Result {
+	case combat.HitPerfectDodge:
+		frames, scale := g.player.GetPerfectDodgeSlowMotion()
+		g.slowMotion.Start(frames, scale)
 
-		bossHitbox := g.boss.GetHitbox()
-
-		if bossHitbox.Intersects(playerHurtbox) {
-			contactDamage := 5
+	case combat.HitParried:
+		g.hitStop.Start(8)
+		g.startFlash(12)
+	}
 
-			direction := g.player.Position.Sub(g.boss.Position).Normalize()
-			knockback := direction.Mul(6)
+	return outcome
+}
 
-			g.player.TakeDamage(contactDamage, knockback)
+// recordBreakdown guarda el desglose del último golpe que conectó (debug)
+func (g *Game) recordBreakdown(outcome combat.HitOutcome) {
+	if outcome.Connected() {
+		g.lastBreakdown = outcome.Breakdown.String()
+	}
+}
 
-			// Emitir evento
-			g.eventSystem.EmitEvent(combat.CombatEvent{
-				Type:     combat.EventDamageDealt,
-				Damage:   contactDamage,
-				Position: g.player.Position,
-				Attacker: "boss",
-				Target:   "player",
-			})
-		}
+// inflictsFor retorna los efectos que aplica un tipo de daño al conectar
+func inflictsFor(damageType combat.DamageType) []combat.StatusType {
+	if damageType == combat.DamageFire {
+		return []combat.StatusType{combat.StatusBurn}
 	}
+	return nil
+}
+
+// reflectProjectile devuelve un proyectil del boss hacia él tras un parry
+func (g *Game) reflectProjectile(proj *projectiles.Projectile) {
+	direction := g.boss.Position.Sub(proj.Position).Normalize()
+
+	proj.Owner = g.player.GetActorID()
+	proj.AttackID = combat.NewAttackID() // El reflejo es un ataque nuevo
+	proj.Age = 0
+	proj.Speed *= 1.5
+	proj.Velocity = direction.Mul(proj.Speed)
+	proj.Damage *= 2
+	proj.DamageType = combat.DamageMagic // El boss resiste el fuego, no su propio reflejo
+	proj.Color = color.RGBA{255, 255, 255, 255}
+
+	g.particleSystem.Emit(proj.Position, 10, color.RGBA{255, 255, 255, 255})
 }
 
 // ============================================================================
 // MÉTODOS DE DRAW POR ESTADO
 // ============================================================================
 
-func (g *Game) drawMainMenu(screen *ebiten.Image) {
-	// TODO
-}
-
 func (g *Game) drawPlaying(screen *ebiten.Image) {
 	// 1. Dibujar arena
 	g.arena.Draw(screen)
 
-	// 2. Dibujar boss
+	// 2. Dibujar boss y sus minions
 	g.boss.Draw(screen)
+	g.drawMinions(screen)
 
 	// 3. Dibujar proyectiles (NUEVO - detrás del jugador)
 	g.projectileManager.Draw(screen)
@@ -932,7 +1463,8 @@ func (g *Game) drawPlaying(screen *ebiten.Image) {
 	msg += "  Z/⬜        = Atacar\n"
 	msg += "  X/⚪/R2     = Dash\n"
 	msg += "  Down+Z     = Pogo\n"
-	msg += "  Q/L1       = Disparar (mantén para cargar)\n\n"
+	msg += "  Q/L1       = Disparar (mantén para cargar)\n"
+	msg += "  E/R1       = Guardia (parry justo antes del golpe)\n\n"
 	msg += "✨ NUEVO:\n"
 	msg += "  💥 Sistema de proyectiles\n"
 	msg += "  🎯 Object pooling (reutilización)\n"
@@ -967,32 +1499,63 @@ func (g *Game) drawGameOver(screen *ebiten.Image) {
 	overlay.Fill(color.RGBA{0, 0, 0, 200})
 	screen.DrawImage(overlay, nil)
 
-	stats := g.eventSystem.GetStats()
+	msg := "💀 GAME OVER\n\n" + g.formatFightSummary() + g.formatLearnedHabits() +
+		"\nPresiona R (teclado) o\n" +
+		"△/Y (gamepad) para reintentar\n" +
+		"M para volver al menú"
 
-	msg := fmt.Sprintf(
-		"💀 GAME OVER\n\n"+
-			"Daño hecho: %d\n"+
-			"Daño recibido: %d\n"+
-			"Combo máximo: %d\n"+
-			"Críticos: %d\n\n"+
-			"Presiona R (teclado) o\n"+
-			"△/Y (gamepad) para reintentar",
-		stats.PlayerDamageDealt,
-		stats.PlayerDamageTaken,
-		stats.HighestCombo,
-		stats.CriticalHits,
-	)
-
-	ebitenutil.DebugPrintAt(screen, msg, ScreenWidth/2-120, ScreenHeight/2-60)
+	ebitenutil.DebugPrintAt(screen, msg, 80, 140)
+	g.drawFightReport(screen, 420, 140)
 }
 
 func (g *Game) drawVictory(screen *ebiten.Image) {
 	g.drawPlaying(screen)
 
 	overlay := ebiten.NewImage(ScreenWidth, ScreenHeight)
-	overlay.Fill(color.RGBA{255, 215, 0, 100})
+	overlay.Fill(color.RGBA{0, 0, 0, 170})
 	screen.DrawImage(overlay, nil)
 
+	title := "🏆 ¡VICTORIA!\n\n"
+	if len(g.rush) > 1 {
+		// Estadísticas, reporte y hábitos son de la última pelea del rush
+		title = fmt.Sprintf("🏆 ¡BOSS RUSH COMPLETADO!\n\nÚltima pelea: %s\n\n", g.boss.Name())
+	}
+
+	msg := title + g.formatFightSummary() + g.formatLearnedHabits() +
+		"\nMódulo 7 completado 🎉\n\n" +
+		"Presiona R (teclado) o\n" +
+		"△/Y (gamepad) para jugar otra vez\n" +
+		"M para volver al menú"
+
+	ebitenutil.DebugPrintAt(screen, msg, 80, 140)
+	g.drawFightReport(screen, 420, 140)
+}
+
+// formatLearnedHabits retorna lo que el boss aprendió del jugador en la pelea
+func (g *Game) formatLearnedHabits() string {
+	habits := g.boss.GetHabits()
+
+	text := "\nEl boss aprendió:\n"
+	learned := false
+	for habit := ai.Habit(0); habit < ai.HabitCount; habit++ {
+		if habits.Count(habit) == 0 {
+			continue
+		}
+		learned = true
+
+		text += fmt.Sprintf("  %s: %dx (%.0f%%)\n", habit, habits.Count(habit), habits.Peak(habit)*100)
+		if counters := g.boss.GetHabitCounters(habit); len(counters) > 0 {
+			text += "    → más " + strings.Join(counters, ", ") + "\n"
+		}
+	}
+	if !learned {
+		text += "  nada (¡juego variado!)\n"
+	}
+	return text
+}
+
+// formatFightSummary retorna las estadísticas generales de la pelea
+func (g *Game) formatFightSummary() string {
 	stats := g.eventSystem.GetStats()
 
 	accuracy := 0.0
@@ -1000,30 +1563,50 @@ func (g *Game) drawVictory(screen *ebiten.Image) {
 		accuracy = float64(stats.PlayerAttacksLanded) / float64(stats.PlayerAttacksLanded+stats.PlayerAttacksMissed) * 100
 	}
 
-	msg := fmt.Sprintf(
-		"🏆 ¡VICTORIA!\n\n"+
-			"Daño total: %d\n"+
+	return fmt.Sprintf(
+		"Daño hecho: %d\n"+
 			"Daño recibido: %d\n"+
 			"Combo máximo: %d\n"+
 			"Críticos: %d\n"+
-			"Precisión: %.1f%%\n\n"+
-			"Módulo 7 completado 🎉\n\n"+
-			"Presiona R (teclado) o\n"+
-			"△/Y (gamepad) para jugar otra vez",
+			"Precisión: %.1f%% (%d/%d)\n"+
+			"Golpes bloqueados por el boss: %d\n"+
+			"Posturas rotas: %d\n"+
+			"Ataques del boss interrumpidos: %d\n"+
+			"Minions eliminados: %d\n",
 		stats.PlayerDamageDealt,
 		stats.PlayerDamageTaken,
 		stats.HighestCombo,
 		stats.CriticalHits,
 		accuracy,
+		stats.PlayerAttacksLanded,
+		stats.PlayerAttacksLanded+stats.PlayerAttacksMissed,
+		stats.BossBlocks,
+		stats.BossStaggers,
+		stats.BossFlinches,
+		stats.MinionsKilled,
 	)
-
-	ebitenutil.DebugPrintAt(screen, msg, ScreenWidth/2-130, ScreenWidth/2-80)
 }
 
 // ============================================================================
 // DIBUJO DE EFECTOS VISUALES
 // ============================================================================
 
+// startFlash inicia un destello blanco de pantalla completa
+func (g *Game) startFlash(frames int) {
+	g.flashFrames = frames
+	g.flashDuration = frames
+}
+
+// drawFlash dibuja el destello de pantalla con fade out
+func (g *Game) drawFlash(screen *ebiten.Image) {
+	if g.flashFrames <= 0 || g.flashDuration <= 0 {
+		return
+	}
+
+	alpha := uint8(160 * float64(g.flashFrames) / float64(g.flashDuration))
+	vector.DrawFilledRect(screen, 0, 0, ScreenWidth, ScreenHeight, color.RGBA{255, 255, 255, alpha}, false)
+}
+
 func (g *Game) drawVisualEffects(screen *ebiten.Image) {
 	// Dibujar partículas
 	for _, particle := range g.particleSystem.GetParticles() {
@@ -1105,6 +1688,11 @@ func (g *Game) drawPlayerHUD(screen *ebiten.Image) {
 		staminaColor,
 		"STAMINA")
 
+	// Buff de esquiva perfecta
+	if g.player.DamageBuffTimeLeft > 0 {
+		ebitenutil.DebugPrintAt(screen, "⚡ DAÑO +", int(hudX+110), int(hudY+60))
+	}
+
 	// Info de combo
 	if g.player.ComboCount > 0 {
 		comboText := fmt.Sprintf("COMBO x%d", g.player.ComboCount)
@@ -1116,6 +1704,14 @@ func (g *Game) drawPlayerHUD(screen *ebiten.Image) {
 		warningText := "⚠️ STAMINA BAJA"
 		ebitenutil.DebugPrintAt(screen, warningText, int(hudX+10), int(hudY+60))
 	}
+
+	// Efectos de estado (encima del HUD)
+	g.drawStatusIcons(screen, g.player.Status.Active(), hudX, hudY-26)
+
+	// Indicador de stun: machacar botones
+	if g.player.State == entities.StateStunned {
+		ebitenutil.DebugPrintAt(screen, "¡MACHACA BOTONES!", int(g.player.Position.X-50), int(g.player.Position.Y-60))
+	}
 }
 
 func (g *Game) drawBossHUD(screen *ebiten.Image) {
@@ -1126,14 +1722,17 @@ func (g *Game) drawBossHUD(screen *ebiten.Image) {
 	barY := float32(10)
 
 	// Fondo
-	hudBg := ebiten.NewImage(int(barWidth+20), int(barHeight+40))
+	hudBg := ebiten.NewImage(int(barWidth+20), int(barHeight+50))
 	hudBg.Fill(color.RGBA{0, 0, 0, 180})
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Translate(float64(barX-10), float64(barY-10))
 	screen.DrawImage(hudBg, op)
 
 	// Nombre del boss
-	bossName := fmt.Sprintf("🐉 TITAN - %s", g.boss.Phase.String())
+	bossName := fmt.Sprintf("%s - %s", g.boss.Name(), g.boss.Phase.String())
+	if len(g.rush) > 1 {
+		bossName += fmt.Sprintf("   [Boss Rush %d/%d]", g.rushIndex+1, len(g.rush))
+	}
 	ebitenutil.DebugPrintAt(screen, bossName, int(barX), int(barY))
 
 	// Barra de vida
@@ -1146,16 +1745,48 @@ func (g *Game) drawBossHUD(screen *ebiten.Image) {
 	// Texto de HP
 	hpText := fmt.Sprintf("%d / %d", g.boss.Health, g.boss.MaxHealth)
 	ebitenutil.DebugPrintAt(screen, hpText, int(barX+barWidth/2-30), int(barY+27))
+
+	// Efectos de estado del boss (a la derecha de la barra)
+	g.drawStatusIcons(screen, g.boss.Status.Active(), barX+barWidth+20, barY+20)
+}
+
+// drawStatusIcons dibuja un icono por efecto activo con su tiempo restante
+func (g *Game) drawStatusIcons(screen *ebiten.Image, active []combat.StatusEffect, x, y float32) {
+	iconSize := float32(20)
+	spacing := float32(4)
+
+	for i, effect := range active {
+		iconX := x + float32(i)*(iconSize+spacing)
+		iconColor := effect.Type.Color()
+
+		// Fondo del icono
+		vector.DrawFilledRect(screen, iconX, y, iconSize, iconSize, color.RGBA{0, 0, 0, 180}, false)
+		vector.StrokeRect(screen, iconX, y, iconSize, iconSize, 2, iconColor, false)
+
+		// Letra del efecto
+		ebitenutil.DebugPrintAt(screen, effect.Type.Icon(), int(iconX+7), int(y+2))
+
+		// Barra de duración restante
+		if effect.Duration > 0 {
+			remaining := float32(effect.TimeLeft) / float32(effect.Duration)
+			vector.DrawFilledRect(screen, iconX, y+iconSize-3, iconSize*remaining, 3, iconColor, false)
+		}
+
+		// Stacks
+		if effect.Stacks > 1 {
+			ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%d", effect.Stacks), int(iconX+iconSize-6), int(y-12))
+		}
+	}
 }
 
 func (g *Game) drawStatsHUD(screen *ebiten.Image) {
 	stats := g.eventSystem.GetStats()
 
 	hudX := float32(ScreenWidth - 250)
-	hudY := float32(ScreenHeight - 150)
+	hudY := float32(ScreenHeight - 196)
 
 	// Fondo
-	hudBg := ebiten.NewImage(230, 140)
+	hudBg := ebiten.NewImage(230, 186)
 	hudBg.Fill(color.RGBA{0, 0, 0, 150})
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Translate(float64(hudX), float64(hudY))
@@ -1168,11 +1799,19 @@ func (g *Game) drawStatsHUD(screen *ebiten.Image) {
 			"Golpes: %d\n"+
 			"Combo máx: %d\n"+
 			"Críticos: %d\n"+
+			"Bloqueos: %d / Parries: %d\n"+
+			"Bloqueos del boss: %d\n"+
+			"Esquivas: %d (perfectas: %d)\n"+
 			"Eventos: %d",
 		stats.PlayerDamageDealt,
 		stats.PlayerAttacksLanded,
 		stats.HighestCombo,
 		stats.CriticalHits,
+		stats.PlayerBlocks,
+		stats.PlayerParries,
+		stats.BossBlocks,
+		stats.PlayerDodges,
+		stats.PlayerPerfectDodges,
 		stats.TotalEvents,
 	)
 
@@ -1207,6 +1846,9 @@ func (g *Game) getHealthColor(percent float64) color.RGBA {
 }
 
 func (g *Game) drawDebugHitboxes(screen *ebiten.Image) {
+	// Hurtboxes de los minions
+	g.drawMinionHurtboxes(screen)
+
 	// Hitbox de ataque del jugador
 	playerAttack := g.player.GetAttackHitbox()
 	if playerAttack != nil {
@@ -1251,6 +1893,36 @@ func (g *Game) drawDebugHitboxes(screen *ebiten.Image) {
 			false,
 		)
 	}
+
+	// Hitbox de la picada
+	swoopHitbox := g.boss.GetSwoopHitbox()
+	if swoopHitbox != nil {
+		vector.StrokeRect(
+			screen,
+			float32(swoopHitbox.X),
+			float32(swoopHitbox.Y),
+			float32(swoopHitbox.Width),
+			float32(swoopHitbox.Height),
+			2,
+			color.RGBA{255, 60, 160, 150},
+			false,
+		)
+	}
+
+	// Hitbox del impacto del leap
+	leapHitbox := g.boss.GetLeapHitbox()
+	if leapHitbox != nil {
+		vector.StrokeRect(
+			screen,
+			float32(leapHitbox.X),
+			float32(leapHitbox.Y),
+			float32(leapHitbox.Width),
+			float32(leapHitbox.Height),
+			2,
+			color.RGBA{180, 80, 255, 150},
+			false,
+		)
+	}
 }
 
 // ============================================================================
@@ -1280,17 +1952,20 @@ func (g *Game) drawDebugInfo(screen *ebiten.Image) {
 			"PLAYER:\n"+
 			"HP: %d/%d\n"+
 			"State: %s\n"+
+			"Attack: %s\n"+
 			"━━━━━━━━━━━━━━━━━━━━━━\n"+
 			"BOSS:\n"+
 			"HP: %d/%d\n"+
 			"Phase: %s\n"+
 			"State: %s\n"+
+			"Attack: %s\n"+
 			"Pogos: %d/3\n"+
 			"━━━━━━━━━━━━━━━━━━━━━━\n"+
 			"COMBAT:\n"+
 			"Partículas: %d\n"+
-			"Eventos: %d\n"+
+			"Eventos: %d (perdidos: %d)\n"+
 			"Screen Shake: %v\n"+
+			"Defensa boss: %d\n"+
 			"━━━━━━━━━━━━━━━━━━━━━━\n"+
 			"PROJECTILES:\n"+
 			"Activos: %d\n"+
@@ -1307,14 +1982,18 @@ func (g *Game) drawDebugInfo(screen *ebiten.Image) {
 		g.player.Health,
 		g.player.MaxHealth,
 		g.player.State,
+		formatAttackState(&g.player.Attack),
 		g.boss.Health,
 		g.boss.MaxHealth,
 		g.boss.Phase,
 		g.boss.State,
+		formatAttackState(&g.boss.Attack),
 		g.boss.ConsecutivePogos,
 		len(g.particleSystem.GetParticles()),
 		stats.TotalEvents,
+		stats.DroppedEvents,
 		g.screenShake.IsActive(),
+		g.boss.GetDefenses().Defense,
 		activeProj,
 		createdProj,
 		reusedProj,
@@ -1322,9 +2001,96 @@ func (g *Game) drawDebugInfo(screen *ebiten.Image) {
 		inputMethod,
 	)
 
-	debugBg := ebiten.NewImage(300, 550)
+	debugBg := ebiten.NewImage(300, 595)
 	debugBg.Fill(color.RGBA{0, 0, 0, 180})
 	screen.DrawImage(debugBg, nil)
 
 	ebitenutil.DebugPrint(screen, debugText)
+
+	g.drawCombatLog(screen)
+	g.drawAIScores(screen)
+}
+
+// formatAttackState retorna "nombre fase frame/total" del ataque en curso (debug)
+func formatAttackState(attack *combat.AttackState) string {
+	if !attack.IsRunning() {
+		return "-"
+	}
+	return fmt.Sprintf("%s %s %d/%d", attack.Def.Name, attack.Phase(), attack.Frame, attack.Def.TotalFrames())
+}
+
+// drawAIScores dibuja la última evaluación de la IA del boss (modo debug)
+func (g *Game) drawAIScores(screen *ebiten.Image) {
+	decision := g.boss.GetLastDecision()
+	if len(decision.Scores) == 0 {
+		return
+	}
+
+	panelX := float32(ScreenWidth - 520)
+	panelY := float32(300)
+	lineHeight := float32(16)
+
+	panelBg := ebiten.NewImage(500, int(lineHeight)*(len(decision.Scores)*2+3)+10)
+	panelBg.Fill(color.RGBA{0, 0, 0, 160})
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(float64(panelX), float64(panelY))
+	screen.DrawImage(panelBg, op)
+
+	ebitenutil.DebugPrintAt(screen, "IA BOSS (score = base x fase x repetición x hábitos):", int(panelX+5), int(panelY+5))
+
+	for i, score := range decision.Scores {
+		marker := "  "
+		if score.Name == decision.Chosen {
+			marker = "> "
+		}
+
+		header := fmt.Sprintf("%s%-6s %.2f = %.2f x %.1f x %.2f x %.2f", marker, score.Name, score.Score, score.Base, score.PhaseWeight, score.Repetition, score.Adaptation)
+		if !score.Available {
+			header = fmt.Sprintf("%s%-6s  --  (no disponible)", marker, score.Name)
+		}
+
+		factors := "    "
+		for _, factor := range score.Factors {
+			factors += fmt.Sprintf("%s:%.2f  ", factor.Name, factor.Value)
+		}
+
+		y := panelY + 5 + lineHeight*float32(i*2+1)
+		ebitenutil.DebugPrintAt(screen, header, int(panelX+5), int(y))
+		ebitenutil.DebugPrintAt(screen, factors, int(panelX+5), int(y+lineHeight))
+	}
+
+	// Esquiva de proyectiles
+	dodge := g.dodgeSystem.LastDecision()
+	dodgeText := fmt.Sprintf("Esquiva: %s", dodge.Action)
+	if dodge.Action != ai.DodgeNone {
+		dodgeText += fmt.Sprintf(" (%s, impacto en %.0f frames)", dodge.Threat, dodge.TimeToImpact)
+	}
+	dodgeText += fmt.Sprintf("   restantes: %d", g.dodgeSystem.DodgesLeft())
+	ebitenutil.DebugPrintAt(screen, dodgeText, int(panelX+5), int(panelY+5+lineHeight*float32(len(decision.Scores)*2+1)))
+
+	// Cadena de ataques en curso
+	chainText := fmt.Sprintf("Cadena: %s", g.boss.Chain.String())
+	ebitenutil.DebugPrintAt(screen, chainText, int(panelX+5), int(panelY+5+lineHeight*float32(len(decision.Scores)*2+2)))
+}
+
+// drawCombatLog dibuja el último desglose de daño y el combat log (modo debug)
+func (g *Game) drawCombatLog(screen *ebiten.Image) {
+	entries := g.combatLog.Entries()
+
+	logX := float32(ScreenWidth - 520)
+	logY := float32(90)
+	lineHeight := float32(16)
+
+	logBg := ebiten.NewImage(500, int(lineHeight)*(len(entries)+3)+10)
+	logBg.Fill(color.RGBA{0, 0, 0, 160})
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(float64(logX), float64(logY))
+	screen.DrawImage(logBg, op)
+
+	ebitenutil.DebugPrintAt(screen, "ÚLTIMO GOLPE: "+g.lastBreakdown, int(logX+5), int(logY+5))
+	ebitenutil.DebugPrintAt(screen, "COMBAT LOG:", int(logX+5), int(logY+5+lineHeight*1.5))
+
+	for i, entry := range entries {
+		ebitenutil.DebugPrintAt(screen, entry, int(logX+5), int(logY+5+lineHeight*float32(i+3)))
+	}
 }
@@ -0,0 +1,119 @@
+// internal/core/menu.go
+package core
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/entities"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// ============================================================================
+// MENÚ PRINCIPAL Y BOSS RUSH
+// ============================================================================
+
+// menuOption es una entrada del menú: los bosses que se pelean en orden
+type menuOption struct {
+	Label  string
+	Bosses []entities.BossKind
+}
+
+// menuOptions son las peleas disponibles (más de un boss = boss rush)
+var menuOptions = []menuOption{
+	{Label: "Titán", Bosses: []entities.BossKind{entities.BossTitan}},
+	{Label: "Arpía", Bosses: []entities.BossKind{entities.BossHarpy}},
+	{Label: "Boss Rush", Bosses: []entities.BossKind{entities.BossTitan, entities.BossHarpy}},
+}
+
+func (g *Game) updateMainMenu() {
+	// Navegar con arriba/abajo (un paso por pulsación)
+	up := g.controller.IsUpHeld()
+	if up && !g.menuUpLastFrame {
+		g.menuIndex = (g.menuIndex + len(menuOptions) - 1) % len(menuOptions)
+	}
+	g.menuUpLastFrame = up
+
+	down := g.controller.IsDownHeld()
+	if down && !g.menuDownLastFrame {
+		g.menuIndex = (g.menuIndex + 1) % len(menuOptions)
+	}
+	g.menuDownLastFrame = down
+
+	// Confirmar con Enter o ataque (el salto comparte tecla con arriba)
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || g.controller.IsAttackPressed() {
+		g.startRun(menuOptions[g.menuIndex].Bosses)
+	}
+}
+
+// startRun empieza una partida contra uno o varios bosses seguidos
+func (g *Game) startRun(bosses []entities.BossKind) {
+	g.rush = bosses
+	g.RestartGame()
+}
+
+// spawnBoss reemplaza al boss actual por uno nuevo del tipo indicado
+func (g *Game) spawnBoss(kind entities.BossKind) {
+	g.boss = entities.NewBossOfKind(kind, 1000, 300, g.arena)
+	g.boss.SetTarget(g.player)
+	g.boss.SetDifficulty(g.config.DifficultyLevel)
+}
+
+// advanceRush pasa al siguiente boss del boss rush (false si ya no quedan)
+func (g *Game) advanceRush() bool {
+	if g.rushIndex+1 >= len(g.rush) {
+		return false
+	}
+
+	g.rushIndex++
+	g.spawnBoss(g.rush[g.rushIndex])
+
+	// El jugador recupera parte de la vida perdida y toda la stamina
+	missing := g.player.MaxHealth - g.player.Health
+	g.player.Health += int(float64(missing) * g.config.RushHeal)
+	g.player.Stamina = g.player.MaxStamina
+	g.player.Status.Clear()
+
+	// Cada pelea del rush tiene sus propias estadísticas, igual que el
+	// reporte y los hábitos (que son del boss nuevo)
+	g.eventSystem.ResetStats()
+
+	// Nada del boss anterior sigue en la arena
+	g.projectileManager.Clear()
+	g.dodgeSystem.Reset()
+	g.damagePipeline.Reset()
+	g.bossAttack = trackedAttack{}
+
+	g.startFight()
+	return true
+}
+
+func (g *Game) drawMainMenu(screen *ebiten.Image) {
+	g.arena.Draw(screen)
+
+	overlay := ebiten.NewImage(ScreenWidth, ScreenHeight)
+	overlay.Fill(color.RGBA{0, 0, 0, 170})
+	screen.DrawImage(overlay, nil)
+
+	msg := "⚔️  TITAN'S ARENA\n\nElige tu pelea:\n\n"
+	for i, option := range menuOptions {
+		cursor := "   "
+		if i == g.menuIndex {
+			cursor = " ▶ "
+		}
+
+		bosses := ""
+		for j, kind := range option.Bosses {
+			if j > 0 {
+				bosses += " → "
+			}
+			bosses += kind.String()
+		}
+		msg += fmt.Sprintf("%s%-10s (%s)\n", cursor, option.Label, bosses)
+	}
+	msg += "\n↑/↓ = Elegir   Enter/Z = Pelear"
+
+	ebitenutil.DebugPrintAt(screen, msg, ScreenWidth/2-140, ScreenHeight/2-80)
+}
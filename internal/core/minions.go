@@ -0,0 +1,277 @@
+// internal/core/minions.go
+package core
+
+import (
+	"image/color"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/audio"
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
+	"github.com/MarcosBrindis/boss-arena-go/internal/entities"
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// ============================================================================
+// MINIONS Y ORBES (enemigos dinámicos además del boss)
+// ============================================================================
+
+// minionSpawnSpacing es la separación entre los minions de una oleada
+const minionSpawnSpacing = 60.0
+
+// handleBossSummon crea la oleada que invocó el boss a sus lados
+func (g *Game) handleBossSummon() {
+	if !g.boss.WantsSummon {
+		return
+	}
+	g.boss.WantsSummon = false
+
+	bounds := g.arena.GetBounds()
+	for i, kind := range g.boss.GetSummonWave() {
+		// Alternar lados: izquierda, derecha, más a la izquierda...
+		side := 1.0
+		if i%2 == 0 {
+			side = -1.0
+		}
+		offset := g.boss.Size.X/2 + minionSpawnSpacing*float64(i/2+1)
+		x := utils.Clamp(g.boss.Position.X+side*offset, bounds.X, bounds.X+bounds.Width)
+
+		size := entities.DefaultMinionConfig(kind).Size
+		y := g.boss.GetGroundY() - size.Y/2 - 1
+		if kind == entities.MinionDrone {
+			y = g.boss.Position.Y - g.boss.Size.Y
+		}
+
+		g.spawnMinion(kind, x, y)
+	}
+	g.boss.MinionCount = len(g.minions)
+}
+
+// spawnMinion agrega un minion a la pelea
+func (g *Game) spawnMinion(kind entities.MinionKind, x, y float64) {
+	minion := entities.NewMinion(kind, g.nextMinionIndex, x, y, g.arena)
+	minion.SetTarget(g.player)
+	g.nextMinionIndex++
+
+	g.minions = append(g.minions, minion)
+	g.particleSystem.Emit(minion.Position, 10, color.RGBA{120, 255, 120, 255})
+}
+
+// updateMinions actualiza los minions y retira los muertos (sueltan un orbe)
+func (g *Game) updateMinions() {
+	alive := g.minions[:0]
+	for _, minion := range g.minions {
+		minion.Update()
+		if !minion.IsAlive() {
+			g.onMinionKilled(minion)
+			continue
+		}
+		alive = append(alive, minion)
+	}
+
+	// Soltar las referencias de los que ya no están
+	for i := len(alive); i < len(g.minions); i++ {
+		g.minions[i] = nil
+	}
+	g.minions = alive
+	g.boss.MinionCount = len(g.minions)
+}
+
+// onMinionKilled emite la muerte y suelta un orbe de stamina
+func (g *Game) onMinionKilled(minion *entities.Minion) {
+	g.eventSystem.EmitEvent(combat.CombatEvent{
+		Type:     combat.EventKill,
+		Target:   minion.GetActorID(),
+		Attacker: minion.LastAttacker,
+		Position: minion.Position,
+		Metadata: map[string]interface{}{
+			"minion": minion.Kind.String(),
+		},
+	})
+
+	g.orbs = append(g.orbs, entities.NewStaminaOrb(minion.Position, minion.GetOrbStamina(), g.arena))
+}
+
+// updateOrbs mueve los orbes y los recoge al tocar al jugador
+func (g *Game) updateOrbs() {
+	playerHurtbox := g.player.GetHurtbox()
+
+	active := g.orbs[:0]
+	for _, orb := range g.orbs {
+		orb.Update()
+		if g.player.State != entities.StateDead && orb.GetHitbox().Intersects(playerHurtbox) {
+			orb.Collect(g.player)
+			g.particleSystem.Emit(orb.Position, 6, color.RGBA{80, 255, 120, 255})
+			g.soundSystem.PlaySound(audio.SoundPickup)
+		}
+		if orb.IsActive() {
+			active = append(active, orb)
+		}
+	}
+
+	for i := len(active); i < len(g.orbs); i++ {
+		g.orbs[i] = nil
+	}
+	g.orbs = active
+}
+
+// clearMinions retira minions y orbes (reinicio, fin de la pelea)
+func (g *Game) clearMinions() {
+	g.minions = nil
+	g.orbs = nil
+	g.boss.MinionCount = 0
+}
+
+// ============================================================================
+// COLISIONES CON MINIONS
+// ============================================================================
+
+// checkMinionCollisions resuelve los golpes del jugador y el contacto de los minions
+func (g *Game) checkMinionCollisions() {
+	if g.player.State == entities.StateDead {
+		return
+	}
+
+	for _, minion := range g.minions {
+		if !minion.IsAlive() {
+			continue
+		}
+		g.checkPlayerAttacksMinion(minion)
+		g.checkMinionContact(minion)
+	}
+}
+
+// checkPlayerAttacksMinion aplica el ataque y el pogo del jugador a un minion
+func (g *Game) checkPlayerAttacksMinion(minion *entities.Minion) {
+	attack := g.player.GetCurrentAttack()
+	if attack == nil {
+		return
+	}
+
+	hurtbox := minion.GetHurtbox()
+	hit := combat.Hit{
+		AttackID:      g.player.Attack.ID,
+		AttackName:    attack.Name,
+		Attacker:      g.player.GetActorID(),
+		DamageType:    attack.DamageType,
+		CritChance:    attack.CritChance,
+		BaseKnockback: attack.Knockback,
+		Direction:     minion.Position.Sub(g.player.Position),
+		Source:        g.player.Position,
+		Position:      minion.Position,
+	}
+
+	if attackHitbox := g.player.GetAttackHitbox(); attackHitbox != nil && attackHitbox.Intersects(hurtbox) {
+		hit.BaseDamage = g.player.GetAttackDamage()
+		hit.ComboCount = g.player.ComboCount
+		hit.ComboMultiplier = 1.0 + float64(g.player.ComboCount)*0.1
+		g.hitMinion(hit, minion)
+	}
+
+	// Pogo: rebota igual que sobre el boss
+	if downAirHitbox := g.player.GetDownAirAttackHitbox(); downAirHitbox != nil && downAirHitbox.Intersects(hurtbox) {
+		hit.BaseDamage = g.player.GetDownAirAttackDamage()
+		if g.hitMinion(hit, minion).Connected() {
+			g.player.PogoBounce()
+		}
+	}
+}
+
+// hitMinion pasa un golpe por el pipeline y recuerda quién conectó
+// (la muerte se le atribuye al último que golpeó)
+func (g *Game) hitMinion(hit combat.Hit, minion *entities.Minion) combat.HitOutcome {
+	outcome := g.damagePipeline.Process(hit, minion)
+	g.recordBreakdown(outcome)
+	if outcome.Connected() {
+		minion.LastAttacker = hit.Attacker
+	}
+	return outcome
+}
+
+// checkMinionContact daña al jugador que toca a un minion
+func (g *Game) checkMinionContact(minion *entities.Minion) {
+	if !minion.CanDealContact() || !minion.GetHitbox().Intersects(g.player.GetHurtbox()) {
+		return
+	}
+
+	// Daño continuo: la invulnerabilidad post-golpe lo limita
+	g.hitPlayer(combat.Hit{
+		AttackName:    minion.Kind.String() + "_contact",
+		Attacker:      minion.GetActorID(),
+		BaseDamage:    minion.GetContactDamage(),
+		DamageType:    combat.DamagePhysical,
+		BaseKnockback: minion.GetContactKnockback(),
+		Direction:     g.player.Position.Sub(minion.Position),
+		Source:        minion.Position,
+		Position:      g.player.Position,
+		Contact:       true,
+	})
+}
+
+// checkProjectilesHitMinions aplica los impactos de proyectiles a los minions.
+// Cada proyectil golpea como mucho a uno (el primero de la lista que cruza
+// antes de chocar con la arena).
+func (g *Game) checkProjectilesHitMinions() {
+	for _, minion := range g.minions {
+		if !minion.IsAlive() {
+			continue
+		}
+
+		g.gridCandidates = g.queryProjectiles(minion.GetHurtbox(), minion.GetActorID())
+		for _, i := range g.gridCandidates {
+			// Muerto por un proyectil anterior: los demás siguen de largo
+			if !minion.IsAlive() {
+				break
+			}
+			proj, sweep := g.projectileBuf[i], &g.projectileSweeps[i]
+			if !proj.IsActive {
+				continue
+			}
+			hit := sweep.start.Sweep(sweep.delta, minion.GetHurtbox())
+			if !hit.Hit || hit.Time > sweep.reach {
+				continue
+			}
+			impact := proj.ImpactPoint(hit.Time)
+
+			outcome := g.hitMinion(combat.Hit{
+				AttackID:      proj.AttackID,
+				AttackName:    proj.Type.String(),
+				Attacker:      proj.Owner,
+				BaseDamage:    proj.Damage,
+				DamageType:    proj.DamageType,
+				BaseKnockback: 2,
+				Direction:     proj.Velocity,
+				Source:        impact,
+				Position:      impact,
+				Ranged:        true,
+				Inflicts:      inflictsFor(proj.DamageType),
+			}, minion)
+			if outcome.Connected() {
+				g.particleSystem.Emit(minion.Position, 6, color.RGBA{255, 100, 100, 255})
+			}
+			proj.IsActive = false
+		}
+	}
+}
+
+// ============================================================================
+// DIBUJO
+// ============================================================================
+
+// drawMinions dibuja minions y orbes
+func (g *Game) drawMinions(screen *ebiten.Image) {
+	for _, orb := range g.orbs {
+		orb.Draw(screen)
+	}
+	for _, minion := range g.minions {
+		minion.Draw(screen)
+	}
+}
+
+// drawMinionHurtboxes dibuja los hurtboxes de los minions (modo debug)
+func (g *Game) drawMinionHurtboxes(screen *ebiten.Image) {
+	for _, minion := range g.minions {
+		hurtbox := minion.GetHurtbox()
+		vector.StrokeRect(screen, float32(hurtbox.X), float32(hurtbox.Y), float32(hurtbox.Width), float32(hurtbox.Height), 1, color.RGBA{255, 255, 0, 150}, false)
+	}
+}
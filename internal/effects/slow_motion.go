@@ -0,0 +1,92 @@
+package effects
+
+import "sync"
+
+// SlowMotion ralentiza el juego saltando frames de lógica (THREAD-SAFE)
+// Ejemplo: con scale 0.5 solo se actualiza 1 de cada 2 frames
+type SlowMotion struct {
+	duration    int
+	elapsed     int
+	scale       float64
+	accumulator float64
+	skipFrame   bool
+	isActive    bool
+	mu          sync.Mutex
+}
+
+// NewSlowMotion crea un nuevo efecto de cámara lenta
+func NewSlowMotion() *SlowMotion {
+	return &SlowMotion{}
+}
+
+// Start inicia la cámara lenta durante duration frames reales (THREAD-SAFE)
+func (sm *SlowMotion) Start(duration int, scale float64) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if scale <= 0 {
+		scale = 0.1
+	}
+	if scale > 1 {
+		scale = 1
+	}
+
+	sm.duration = duration
+	sm.elapsed = 0
+	sm.scale = scale
+	sm.accumulator = 0
+	sm.skipFrame = false
+	sm.isActive = true
+}
+
+// Update avanza un frame real y decide si este frame se salta (THREAD-SAFE)
+func (sm *SlowMotion) Update() {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if !sm.isActive {
+		sm.skipFrame = false
+		return
+	}
+
+	sm.elapsed++
+	if sm.elapsed >= sm.duration {
+		sm.isActive = false
+		sm.skipFrame = false
+		return
+	}
+
+	// Acumular tiempo de juego: solo se ejecuta lógica al completar un frame entero
+	sm.accumulator += sm.scale
+	if sm.accumulator >= 1 {
+		sm.accumulator -= 1
+		sm.skipFrame = false
+	} else {
+		sm.skipFrame = true
+	}
+}
+
+// ShouldSkipFrame retorna true si este frame no debe actualizar la lógica (THREAD-SAFE)
+func (sm *SlowMotion) ShouldSkipFrame() bool {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	return sm.skipFrame
+}
+
+// IsActive retorna si la cámara lenta está activa (THREAD-SAFE)
+func (sm *SlowMotion) IsActive() bool {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	return sm.isActive
+}
+
+// Stop detiene la cámara lenta inmediatamente (THREAD-SAFE)
+func (sm *SlowMotion) Stop() {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	sm.isActive = false
+	sm.skipFrame = false
+}
@@ -5,6 +5,9 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/MarcosBrindis/boss-arena-go/internal/ai"
+	"github.com/MarcosBrindis/boss-arena-go/internal/audio"
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
 	"github.com/MarcosBrindis/boss-arena-go/internal/config"
 	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
 	"github.com/MarcosBrindis/boss-arena-go/internal/world"
@@ -14,6 +17,9 @@ import (
 
 // Boss representa al jefe final
 type Boss struct {
+	// Identidad
+	ID combat.ActorID
+
 	// Posición y física
 	Position utils.Vector2
 	Velocity utils.Vector2
@@ -28,32 +34,87 @@ type Boss struct {
 	// Combate
 	Health         int
 	MaxHealth      int
-	Damage         int
 	AttackCooldown int
 	AttackRange    float64
+	Attack         combat.AttackState // Ataque en curso (frame data)
 
 	// IA
 	Target           *Player
 	AggroRange       float64
-	AttackDelay      int
 	DecisionTimer    int
 	NextAction       BossState
 	ConsecutivePogos int
+	brain            *ai.UtilitySystem // IA de utilidad (elige el próximo movimiento)
+	habits           *ai.HabitModel    // Lo que el boss aprendió del jugador en esta pelea
+
+	// Cómo se mueve (suelo o vuelo)
+	locomotion bossLocomotion
 
 	// Ataques especiales
 	SlamCooldown    int
 	ChargeCooldown  int
 	RoarCooldown    int
-	SlamDuration    int
-	ChargeDuration  int
 	RoarDuration    int
 	ChargeSpeed     float64
 	ChargeDirection utils.Vector2
 
+	// Vuelo: picada en V y ráfagas desde arriba
+	SwoopCooldown   int
+	swoopVelocity   utils.Vector2
+	swoopStartY     float64 // Altura a la que vuelve tras la picada
+	swoopBottomY    float64 // Fondo de la V
+	VolleyCooldown  int
+	volleyShotsLeft int
+	volleyTimer     int
+
+	// Invocación de minions (MinionCount lo actualiza el juego)
+	SummonCooldown int
+	SummonTimeLeft int
+	WantsSummon    bool
+	MinionCount    int
+
+	// Saltos
+	LeapCooldown    int
+	EscapeCooldown  int
+	Leap            LeapState
+	WantsLeapImpact bool
+	chainSlam       bool // El impacto del leap termina en slam
+
+	// Defensa
+	BackstepCooldown  int
+	BackstepTimeLeft  int
+	backstepVelocityX float64
+	GuardCooldown     int
+	GuardTimeLeft     int
+	BlockedHits       int  // Golpes bloqueados en la guardia actual
+	counterReady      bool // Bloqueó suficientes golpes: contraataca
+
+	// Cadena de ataques en curso
+	Chain    ChainState
+	shootAim float64 // Desvío del próximo disparo (abanicos)
+
+	// Esquiva de proyectiles
+	DodgeTimeLeft  int
+	dodgeAction    ai.DodgeAction
+	dodgeVelocityX float64
+
 	// Stun
 	StunDuration int
 	StunTimeLeft int
 
+	// Armadura: destello al absorber un golpe y respiro entre flinches
+	ArmorFlash     int
+	FlinchCooldown int
+
+	// Postura: al romperse queda aturdido y recibe daño extra
+	Poise           float64
+	MaxPoise        float64
+	poiseRegenDelay int
+	poiseBroken     bool
+
+	// Efectos de estado (stun, slow, burn...)
+	Status *combat.StatusEffects
+
 	// Transición de fase
 	TransitionTimer int
 	IsInvulnerable  bool
@@ -74,35 +135,136 @@ type Boss struct {
 	ShootDelay     int
 	WantsToShoot   bool
 	ProjectileType int // Tipo de proyectil a disparar
+
+	// Shockwave del slam
+	WantsShockwave bool
+
+	// Aviso del próximo movimiento (telegraph)
+	Telegraph           TelegraphState
+	WantsTelegraphSound bool
+	difficulty          int // 1 = fácil, 2 = normal, 3 = difícil
 }
 
 // BossConfig contiene la configuración del boss
 type BossConfig struct {
+	// Identidad
+	Kind        BossKind
+	Name        string
+	MaxHealth   int
+	Size        utils.Vector2
+	PhaseColors map[BossPhase]color.RGBA
+
 	// Movimiento
 	WalkSpeed   float64
 	ChargeSpeed float64
 	JumpForce   float64
 
 	// Combate
-	AttackDamage   int
+	BasicAttack    combat.AttackDefinition
 	AttackRange    float64
 	AttackCooldown int
 
 	// Ataques especiales (cooldowns en frames)
+	SlamAttack   combat.AttackDefinition
 	SlamCooldown int
-	SlamDuration int
-	SlamDamage   int
 	SlamRadius   float64
 
+	ShockwaveSpeed       float64
+	ShockwaveDamage      int
+	ShockwaveLifetime    int                             // Frames que viaja cada onda (alcance = velocidad × vida)
+	ShockwaveSplitFrames int                             // Fase 3: frames hasta dividirse en onda doble
+	ShockwaveDamageType  map[BossPhase]combat.DamageType // Por fase (sin entrada = físico)
+
+	ChargeAttack   combat.AttackDefinition
 	ChargeCooldown int
-	ChargeDuration int
-	ChargeDamage   int
 
 	RoarCooldown int
 	RoarDuration int
 	RoarStunTime int
 	RoarRange    float64
 
+	// Invocación: oleadas por fase (la Fase 1 no invoca)
+	SummonDuration int
+	SummonCooldown int
+	MaxMinions     int
+	SummonWaves    map[BossPhase][]MinionKind
+
+	// Leap: salta hacia donde va a estar el jugador y cae con un impacto
+	LeapAttack          combat.AttackDefinition
+	LeapCooldown        int
+	LeapMaxSpeed        float64               // Velocidad horizontal máxima en el aire
+	LeapMaxLead         float64               // Cuánto se adelanta al movimiento del jugador
+	LeapChainSlamChance map[BossPhase]float64 // Probabilidad de encadenar un slam al caer
+
+	// Salto de escape (por encima del jugador al estar acorralado)
+	EscapeCooldown  int
+	EscapeJumpScale float64 // Multiplicador de JumpForce (tiene que pasar por encima)
+	EscapeClearance float64 // Distancia extra al caer del otro lado del jugador
+
+	// Backstep
+	BackstepSpeed    float64
+	BackstepHop      float64 // Velocidad vertical del saltito
+	BackstepFrames   int
+	BackstepCooldown int
+
+	// Guardia y contraataque
+	GuardDuration        int
+	GuardCooldown        int
+	GuardDamageReduction float64 // 0.8 = bloquea el 80% del daño
+	GuardBreakStun       int     // Frames aturdido si le rompen la guardia
+	CounterThreshold     int     // Golpes bloqueados para contraatacar
+	CounterAttack        combat.AttackDefinition
+
+	// Parry
+	ParryStaggerTime int // Frames aturdido tras recibir un parry
+
+	// Respuesta a tres pogos seguidos (Idle = ninguna)
+	PogoCounter BossState
+
+	// Vuelo (solo bosses voladores: ignoran la gravedad salvo aturdidos)
+	Flying         bool
+	HoverHeight    float64 // Altura del centro sobre el suelo al planear
+	MinHoverHeight float64 // Nunca baja de aquí si no está aturdido
+	HoverOffsetX   float64 // Se queda a un lado del jugador, no justo encima
+	TakeoffSpeed   float64
+
+	SwoopAttack   combat.AttackDefinition
+	SwoopSpeed    float64
+	SwoopCooldown int
+
+	VolleyShots    map[BossPhase]int
+	VolleyInterval int     // Frames entre disparos de la ráfaga
+	VolleySpread   float64 // Apertura total del abanico en radianes
+	VolleyCooldown int
+
+	// Armadura por movimiento (los que no aparecen no tienen)
+	MoveArmor        map[BossState]combat.ArmorLevel
+	FlinchFrames     int // Aturdimiento corto al interrumpir un movimiento
+	FlinchCooldown   int // Frames sin poder volver a interrumpirlo
+	ArmorFlashFrames int
+
+	// Postura (poise)
+	PhasePoise         map[BossPhase]float64
+	PoiseRegen         float64 // Por frame
+	PoiseRegenDelay    int     // Frames sin recibir golpes antes de regenerar
+	PoiseBreakStun     int     // Frames aturdido al romperse
+	StaggerDamageBonus float64 // Daño extra mientras está roto (0.5 = +50%)
+
+	// Defensas por fase
+	PhaseDefenses map[BossPhase]combat.Defenses
+
+	// Avisos de cada movimiento y su escala (índice = dificultad - 1)
+	Telegraphs            map[BossState]Telegraph
+	DifficultyWindUpScale []float64
+	PhaseWindUpScale      map[BossPhase]float64
+
+	// Cadenas de ataques por fase
+	Chains           map[BossPhase][]AttackChain
+	ChainChance      map[BossPhase]float64 // Probabilidad de encadenar al elegir un movimiento
+	ChainGap         map[BossPhase]int     // Frames entre golpes (ventana para interrumpir)
+	ChainWindUpScale float64               // Los golpes encadenados avisan menos
+	ChainRecovery    int                   // Frames antes de volver a decidir al terminar
+
 	// IA
 	AggroRange    float64
 	DecisionDelay int
@@ -116,26 +278,83 @@ type BossConfig struct {
 // DefaultBossConfig retorna la configuración por defecto
 func DefaultBossConfig() BossConfig {
 	return BossConfig{
+		// Identidad
+		Kind:      BossTitan,
+		Name:      "🐉 TITAN",
+		MaxHealth: 1000,
+		Size:      utils.NewVector2(100, 120),
+		PhaseColors: map[BossPhase]color.RGBA{
+			Phase1: {255, 69, 0, 255},  // Rojo fuego
+			Phase2: {255, 99, 71, 255}, // Naranja
+			Phase3: {255, 165, 0, 255}, // Amarillo
+		},
+
 		// Movimiento
 		WalkSpeed:   2.0,
 		ChargeSpeed: 10.0,
 		JumpForce:   10.0,
 
-		// Combate
-		AttackDamage:   15,
+		// Combate (el boss mide 100x120)
+		BasicAttack: combat.AttackDefinition{
+			Name:     "boss_attack",
+			Startup:  8, // El aviso cubre el resto de la anticipación
+			Active:   10,
+			Recovery: 10, // Ventana de castigo
+			Hitboxes: []combat.HitboxShape{
+				{OffsetX: 50, OffsetY: -40, Width: 110, Height: 80},
+			},
+			Damage:     15,
+			DamageType: combat.DamagePhysical,
+			Knockback:  8,
+			Parryable:  true,
+		},
 		AttackRange:    80.0,
 		AttackCooldown: 60, // 1 segundo
 
-		// Slam (golpe en el suelo)
+		// Slam (golpe en el suelo): impacto a ras de suelo alrededor de los pies
+		// (el alcance largo lo cubren las ondas de choque)
+		SlamAttack: combat.AttackDefinition{
+			Name:     "boss_slam",
+			Startup:  8, // Caída (el aviso cubre la subida)
+			Active:   6,
+			Recovery: 9,
+			Hitboxes: []combat.HitboxShape{
+				{OffsetX: -90, OffsetY: 20, Width: 180, Height: 40},
+			},
+			Damage:     30,
+			DamageType: combat.DamagePhysical,
+			Knockback:  12,
+			Parryable:  false, // Solo se puede bloquear
+		},
 		SlamCooldown: 180, // 3 segundos
-		SlamDuration: 30,  // 0.5 segundos
-		SlamDamage:   25,
 		SlamRadius:   150.0,
 
-		// Charge (carga)
+		// Shockwave (ondas que viajan por el suelo)
+		ShockwaveSpeed:       5.0,
+		ShockwaveDamage:      20,
+		ShockwaveLifetime:    240,
+		ShockwaveSplitFrames: 25,
+		// Fase 3: la onda doble es daño verdadero (ignora defensa y resistencias;
+		// solo se salta o se bloquea)
+		ShockwaveDamageType: map[BossPhase]combat.DamageType{
+			Phase3: combat.DamageTrue,
+		},
+
+		// Charge (carga): todo el cuerpo es hitbox
+		ChargeAttack: combat.AttackDefinition{
+			Name:     "boss_charge",
+			Startup:  0,
+			Active:   60, // 1 segundo
+			Recovery: 0,
+			Hitboxes: []combat.HitboxShape{
+				{OffsetX: -50, OffsetY: -60, Width: 100, Height: 120},
+			},
+			Damage:     30,
+			DamageType: combat.DamagePhysical,
+			Knockback:  8,
+			Parryable:  true,
+		},
 		ChargeCooldown: 240, // 4 segundos
-		ChargeDuration: 60,  // 1 segundo
-		ChargeDamage:   30,
 
 		// Roar (rugido)
 		RoarCooldown: 300, // 5 segundos
@@ -143,6 +362,244 @@ func DefaultBossConfig() BossConfig {
 		RoarStunTime: 60,  // 1 segundo de stun
 		RoarRange:    200.0,
 
+		// Invocación
+		SummonDuration: 40,
+		SummonCooldown: 600, // 10 segundos
+		MaxMinions:     4,
+		SummonWaves: map[BossPhase][]MinionKind{
+			Phase2: {MinionCrawler, MinionCrawler},
+			Phase3: {MinionCrawler, MinionDrone, MinionDrone},
+		},
+
+		// Leap: el impacto cubre el suelo alrededor de los pies
+		LeapAttack: combat.AttackDefinition{
+			Name:     "boss_leap",
+			Startup:  0, // El salto es la anticipación
+			Active:   6,
+			Recovery: 20, // Ventana de castigo al aterrizar
+			Hitboxes: []combat.HitboxShape{
+				{OffsetX: -80, OffsetY: 20, Width: 160, Height: 50},
+			},
+			Damage:     25,
+			DamageType: combat.DamagePhysical,
+			Knockback:  10,
+			Parryable:  false,
+		},
+		LeapCooldown: 300, // 5 segundos
+		LeapMaxSpeed: 12.0,
+		LeapMaxLead:  200.0,
+		LeapChainSlamChance: map[BossPhase]float64{
+			Phase2: 0.4,
+			Phase3: 1.0,
+		},
+
+		// Escape
+		EscapeCooldown:  240, // 4 segundos
+		EscapeJumpScale: 1.2,
+		EscapeClearance: 60.0,
+
+		// Backstep
+		BackstepSpeed:    9.0,
+		BackstepHop:      4.0,
+		BackstepFrames:   14,
+		BackstepCooldown: 150, // 2.5 segundos
+
+		// Guardia: aguanta golpes de frente, se rompe con disparos cargados
+		GuardDuration:        90,  // 1.5 segundos
+		GuardCooldown:        240, // 4 segundos
+		GuardDamageReduction: 0.8,
+		GuardBreakStun:       75,
+		CounterThreshold:     3,
+		CounterAttack: combat.AttackDefinition{
+			Name:     "boss_counter",
+			Startup:  5, // Rápido: castiga al que insiste contra la guardia
+			Active:   8,
+			Recovery: 14,
+			Hitboxes: []combat.HitboxShape{
+				{OffsetX: 50, OffsetY: -50, Width: 130, Height: 100},
+			},
+			Damage:     25,
+			DamageType: combat.DamagePhysical,
+			Knockback:  12,
+			Parryable:  true,
+		},
+
+		// Parry
+		ParryStaggerTime: 75, // 1.25 segundos para castigar
+
+		// Tres pogos seguidos: se los sacude con un slam
+		PogoCounter: BossStateSlam,
+
+		// Armadura: slam y charge no se detienen con nada
+		MoveArmor: map[BossState]combat.ArmorLevel{
+			BossStateSlam:    combat.ArmorSuper,
+			BossStateCharge:  combat.ArmorSuper,
+			BossStateLeap:    combat.ArmorHyper,
+			BossStateRoar:    combat.ArmorHyper,
+			BossStateCounter: combat.ArmorHyper,
+			BossStateGuard:   combat.ArmorHyper, // La guardia no se interrumpe (se rompe)
+		},
+		FlinchFrames:     20,
+		FlinchCooldown:   90,
+		ArmorFlashFrames: 10,
+
+		// Postura: la fase 2 aguanta más, en fase 3 (furia) se rompe antes
+		PhasePoise: map[BossPhase]float64{
+			Phase1: 100,
+			Phase2: 120,
+			Phase3: 80,
+		},
+		PoiseRegen:         0.25,
+		PoiseRegenDelay:    90,
+		PoiseBreakStun:     120, // 2 segundos de castigo
+		StaggerDamageBonus: 0.5,
+
+		// Defensas por fase: resiste el fuego, es débil a la magia.
+		// En fase 3 (furia) baja la guardia física.
+		PhaseDefenses: map[BossPhase]combat.Defenses{
+			Phase1: {
+				Defense: 3,
+				Resistances: map[combat.DamageType]float64{
+					combat.DamagePhysical: 0.1,
+					combat.DamageFire:     0.5,
+					combat.DamageMagic:    -0.25,
+				},
+			},
+			Phase2: {
+				Defense: 5,
+				Resistances: map[combat.DamageType]float64{
+					combat.DamagePhysical: 0.2,
+					combat.DamageFire:     0.75,
+				},
+			},
+			Phase3: {
+				Defense: 1,
+				Resistances: map[combat.DamageType]float64{
+					combat.DamageFire:  0.9,
+					combat.DamageMagic: -0.5,
+				},
+			},
+		},
+
+		// Avisos: cada movimiento tiene su anticipación legible
+		Telegraphs: map[BossState]Telegraph{
+			BossStateAttacking: {
+				WindUp: 14,
+				Cues:   CueFlash | CueGlow,
+				Color:  color.RGBA{255, 255, 255, 255},
+				Sound:  audio.SoundTelegraph,
+			},
+			BossStateSlam: {
+				WindUp: 28,
+				Cues:   CueFlash | CueGlow | CueGroundMarker,
+				Color:  color.RGBA{255, 60, 60, 255},
+				Sound:  audio.SoundTelegraph,
+			},
+			BossStateCharge: {
+				WindUp: 32,
+				Cues:   CueGlow | CueDirectionLine,
+				Color:  color.RGBA{255, 140, 0, 255},
+				Sound:  audio.SoundTelegraph,
+			},
+			BossStateRoar: {
+				WindUp: 24,
+				Cues:   CueGlow | CueGroundMarker,
+				Color:  color.RGBA{255, 255, 0, 255},
+				Sound:  audio.SoundBossRoar,
+			},
+			BossStateShooting: {
+				WindUp: 20,
+				Cues:   CueFlash | CueDirectionLine,
+				Color:  color.RGBA{255, 69, 0, 255},
+				Sound:  audio.SoundTelegraph,
+			},
+			BossStateSummon: {
+				WindUp: 30,
+				Cues:   CueGlow,
+				Color:  color.RGBA{120, 255, 120, 255},
+				Sound:  audio.SoundBossRoar,
+			},
+			BossStateLeap: {
+				WindUp: 22,
+				Cues:   CueGlow | CueGroundMarker,
+				Color:  color.RGBA{180, 80, 255, 255},
+				Sound:  audio.SoundTelegraph,
+			},
+			// El escape no tiene aviso: no hace daño
+		},
+		DifficultyWindUpScale: []float64{1.35, 1.0, 0.75},
+		PhaseWindUpScale: map[BossPhase]float64{
+			Phase1: 1.0,
+			Phase2: 0.85,
+			Phase3: 0.7,
+		},
+
+		// Cadenas: cada fase tiene las suyas; en Fase 3 (furia) casi todo encadena
+		Chains: map[BossPhase][]AttackChain{
+			Phase1: {
+				{Name: "doble golpe", Steps: []ChainStep{
+					{Move: BossStateAttacking},
+					{Move: BossStateAttacking},
+					// Si el segundo conecta, remata con el slam
+					{Move: BossStateSlam, When: ChainOnHit},
+				}},
+			},
+			Phase2: {
+				{Name: "golpe-golpe-slam", Steps: []ChainStep{
+					{Move: BossStateAttacking},
+					{Move: BossStateAttacking},
+					{Move: BossStateSlam, When: ChainOnHit, Else: BossStateBackstep},
+				}},
+				{Name: "carga y vuelta", Steps: []ChainStep{
+					{Move: BossStateCharge},
+					// Si falla se da la vuelta y carga otra vez; si acierta, ruge
+					{Move: BossStateCharge, When: ChainOnMiss, Else: BossStateRoar},
+				}},
+				{Name: "abanico", Steps: []ChainStep{
+					{Move: BossStateShooting, Aim: -0.25},
+					{Move: BossStateShooting},
+					{Move: BossStateShooting, Aim: 0.25},
+				}},
+			},
+			Phase3: {
+				{Name: "furia", Steps: []ChainStep{
+					{Move: BossStateAttacking},
+					{Move: BossStateAttacking},
+					{Move: BossStateAttacking},
+					{Move: BossStateSlam, When: ChainOnHit, Else: BossStateCharge},
+				}},
+				{Name: "carga triple", Steps: []ChainStep{
+					{Move: BossStateCharge},
+					{Move: BossStateCharge},
+					{Move: BossStateCharge, When: ChainOnMiss, Else: BossStateRoar},
+					{Move: BossStateSlam},
+				}},
+				{Name: "lluvia", Steps: []ChainStep{
+					{Move: BossStateShooting, Aim: -0.3},
+					{Move: BossStateShooting},
+					{Move: BossStateShooting, Aim: 0.3},
+					{Move: BossStateLeap},
+				}},
+				{Name: "salto y golpes", Steps: []ChainStep{
+					{Move: BossStateLeap},
+					{Move: BossStateAttacking},
+					{Move: BossStateAttacking, When: ChainOnHit, Else: BossStateRoar},
+				}},
+			},
+		},
+		ChainChance: map[BossPhase]float64{
+			Phase1: 0.25,
+			Phase2: 0.5,
+			Phase3: 0.85,
+		},
+		ChainGap: map[BossPhase]int{
+			Phase1: 20,
+			Phase2: 14,
+			Phase3: 8,
+		},
+		ChainWindUpScale: 0.6,
+		ChainRecovery:    40,
+
 		// IA
 		AggroRange:    400.0,
 		DecisionDelay: 30, // Decide cada 0.5 segundos
@@ -154,40 +611,53 @@ func DefaultBossConfig() BossConfig {
 	}
 }
 
-// NewBoss crea un nuevo boss
+// NewBoss crea un nuevo boss (el Titán)
 func NewBoss(x, y float64, arena *world.Arena) *Boss {
-	cfg := DefaultBossConfig()
+	return NewBossWithConfig(DefaultBossConfig(), x, y, arena)
+}
 
-	return &Boss{
+// NewBossWithConfig crea un boss con una configuración concreta (ver NewBossOfKind)
+func NewBossWithConfig(cfg BossConfig, x, y float64, arena *world.Arena) *Boss {
+	boss := &Boss{
+		ID:       combat.BossActor,
 		Position: utils.NewVector2(x, y),
 		Velocity: utils.Zero(),
-		Size:     utils.NewVector2(100, 120), // Boss más grande que el jugador
+		Size:     cfg.Size,
 
 		State:       BossStateIdle,
 		Phase:       Phase1,
 		FacingRight: false, // Empieza mirando a la izquierda
 
-		Health:    1000,
-		MaxHealth: 1000,
-		Damage:    15,
+		Health:    cfg.MaxHealth,
+		MaxHealth: cfg.MaxHealth,
 
 		AggroRange:  cfg.AggroRange,
 		AttackRange: cfg.AttackRange,
 
 		ConsecutivePogos: 0,
 
+		Status: combat.NewStatusEffects(),
+
 		arena:  arena,
 		rng:    rand.New(rand.NewSource(time.Now().UnixNano())),
 		config: cfg,
 
-		bodyColor:   color.RGBA{255, 69, 0, 255}, // Fase 1
+		bodyColor:   cfg.PhaseColors[Phase1],
 		accentColor: color.RGBA{255, 140, 0, 255},
 
 		ShootCooldown:  0,     // NUEVO
 		ShootDelay:     0,     // NUEVO
 		WantsToShoot:   false, // NUEVO
 		ProjectileType: 0,     // NUEVO
+
+		difficulty: 2, // Normal
 	}
+	boss.locomotion = newBossLocomotion(&cfg)
+	boss.brain = newBossBrain(boss)
+	boss.habits = ai.NewHabitModel(ai.DefaultHabitConfig())
+	boss.ResetPoise()
+
+	return boss
 }
 
 // SetTarget establece el objetivo del boss
@@ -205,6 +675,15 @@ func (b *Boss) Update() {
 	// Actualizar temporizadores
 	b.updateTimers()
 
+	// Las observaciones viejas pierden peso
+	b.habits.Update()
+
+	// Efectos de estado
+	b.updateStatusEffects()
+	if b.State == BossStateDead {
+		return
+	}
+
 	// Verificar colisiones
 	b.updateCollisionState()
 
@@ -214,12 +693,15 @@ func (b *Boss) Update() {
 	// Procesar IA
 	b.updateAI()
 
-	// Aplicar física
-	b.applyPhysics()
+	// Aplicar física (suelo o vuelo)
+	b.locomotion.applyPhysics(b)
 
 	// Aplicar movimiento
 	b.applyMovement()
 
+	// Aterrizaje de los saltos
+	b.updateLeap()
+
 	// Actualizar estado
 	b.updateState()
 }
@@ -239,21 +721,38 @@ func (b *Boss) updateTimers() {
 	if b.RoarCooldown > 0 {
 		b.RoarCooldown--
 	}
-
-	// Duración de ataques
-	if b.SlamDuration > 0 {
-		b.SlamDuration--
-		if b.SlamDuration == 0 {
-			b.State = BossStateIdle
-		}
+	if b.LeapCooldown > 0 {
+		b.LeapCooldown--
 	}
-	if b.ChargeDuration > 0 {
-		b.ChargeDuration--
-		if b.ChargeDuration == 0 {
-			b.State = BossStateIdle
-			b.Velocity.X = 0
+	if b.EscapeCooldown > 0 {
+		b.EscapeCooldown--
+	}
+
+	// Ataque en curso (frame data)
+	if b.Attack.IsRunning() {
+		if b.Attack.Advance() {
+			b.endAttack()
+		} else if b.Attack.Is(&b.config.SlamAttack) && b.Attack.IsFirstActiveFrame() {
+			// Momento del impacto: lanzar ondas de choque
+			b.WantsShockwave = true
 		}
 	}
+	// Aviso del próximo movimiento
+	b.updateTelegraph()
+
+	// Esquiva en curso
+	b.updateDodge()
+
+	// Backstep y guardia
+	b.updateDefense()
+
+	// Invocación
+	b.updateSummon()
+
+	// Picada y ráfaga (bosses voladores)
+	b.updateSwoop()
+	b.updateVolley()
+
 	if b.RoarDuration > 0 {
 		b.RoarDuration--
 		if b.RoarDuration == 0 {
@@ -269,6 +768,10 @@ func (b *Boss) updateTimers() {
 		}
 	}
 
+	// Postura y armadura
+	b.updatePoise()
+	b.updateArmor()
+
 	// Transición de fase
 	if b.TransitionTimer > 0 {
 		b.TransitionTimer--
@@ -283,10 +786,6 @@ func (b *Boss) updateTimers() {
 		b.DecisionTimer--
 	}
 
-	// Delay de ataque
-	if b.AttackDelay > 0 {
-		b.AttackDelay--
-	}
 	// Cooldown de disparo (NUEVO)
 	if b.ShootCooldown > 0 {
 		b.ShootCooldown--
@@ -296,6 +795,27 @@ func (b *Boss) updateTimers() {
 	}
 }
 
+// updateStatusEffects avanza los efectos de estado y aplica sus ticks
+func (b *Boss) updateStatusEffects() {
+	damage, heal := b.Status.Update()
+
+	if heal > 0 {
+		b.Health += heal
+		if b.Health > b.MaxHealth {
+			b.Health = b.MaxHealth
+		}
+	}
+
+	// El daño por tiempo no respeta la invulnerabilidad de la transición
+	if damage > 0 && b.State != BossStateTransition {
+		b.Health -= damage
+		if b.Health <= 0 {
+			b.Health = 0
+			b.Die()
+		}
+	}
+}
+
 // updateCollisionState verifica colisiones con el mundo
 func (b *Boss) updateCollisionState() {
 	hitbox := b.GetHitbox()
@@ -330,12 +850,12 @@ func (b *Boss) updatePhase() {
 	}
 
 	// Actualizar color según fase
-	r, g, bl := b.Phase.GetColor()
-	b.bodyColor = color.RGBA{r, g, bl, 255}
+	b.UpdateColor()
 }
 
 // startPhaseTransition inicia la transición de fase
 func (b *Boss) startPhaseTransition() {
+	b.Attack.Stop()
 	b.State = BossStateTransition
 	b.TransitionTimer = 90 // 1.5 segundos
 	b.IsInvulnerable = true
@@ -345,37 +865,41 @@ func (b *Boss) startPhaseTransition() {
 	b.SlamCooldown = 0
 	b.ChargeCooldown = 0
 	b.RoarCooldown = 0
+	b.LeapCooldown = 0
+	b.SummonCooldown = 0
+	b.EndChain()
+
+	// Cada fase tiene su propia postura
+	b.ResetPoise()
 }
 
 // updateState actualiza el estado del boss
 func (b *Boss) updateState() {
 	// Estados que no se pueden interrumpir
-	if b.State == BossStateSlam ||
+	if b.State == BossStateAttacking ||
+		b.State == BossStateSlam ||
 		b.State == BossStateCharge ||
 		b.State == BossStateRoar ||
 		b.State == BossStateStunned ||
-		b.State == BossStateTransition {
+		b.State == BossStateTransition ||
+		b.State == BossStateWindUp ||
+		b.State == BossStateLeap ||
+		b.State == BossStateBackstep ||
+		b.State == BossStateGuard ||
+		b.State == BossStateCounter ||
+		b.State == BossStateSummon ||
+		b.State == BossStateSwoop ||
+		b.State == BossStateVolley ||
+		b.State == BossStateEscape {
 		return
 	}
 
 	// Determinar nuevo estado
-	if !b.IsOnGround {
-		if b.Velocity.Y < 0 {
-			b.State = BossStateJumping
-		} else {
-			b.State = BossStateFalling
-		}
-	} else {
-		if utils.Abs(b.Velocity.X) > 0.5 {
-			b.State = BossStateWalking
-		} else {
-			b.State = BossStateIdle
-		}
-	}
+	b.State = b.locomotion.restingState(b)
 }
 
-// applyPhysics aplica física al boss
-func (b *Boss) applyPhysics() {
+// applyGroundPhysics aplica gravedad y fricción al boss
+func (b *Boss) applyGroundPhysics() {
 	// Durante charge, mantener velocidad constante
 	if b.State == BossStateCharge {
 		b.Velocity = b.ChargeDirection.Mul(b.ChargeSpeed)
@@ -416,6 +940,10 @@ func (b *Boss) applyPhysics() {
 			b.Velocity.X = 0
 		}
 	}
+
+	// El paso lateral y el backstep mantienen su velocidad
+	b.applyDodgeStep()
+	b.applyBackstep()
 }
 
 // applyMovement aplica el movimiento con colisiones
@@ -438,7 +966,7 @@ func (b *Boss) applyMovement() {
 		b.Velocity.X = 0
 		// Si está en charge y choca, detenerlo
 		if b.State == BossStateCharge {
-			b.ChargeDuration = 0
+			b.endAttack()
 		}
 	}
 
@@ -485,8 +1013,7 @@ func (b *Boss) applyMovement() {
 		b.Velocity.X = 0
 		// Detener charge si choca con pared
 		if b.State == BossStateCharge {
-			b.ChargeDuration = 0
-			b.State = BossStateIdle
+			b.endAttack()
 		}
 	}
 	if b.Position.X > 1280-margin-60 {
@@ -494,8 +1021,7 @@ func (b *Boss) applyMovement() {
 		b.Velocity.X = 0
 		// Detener charge si choca con pared
 		if b.State == BossStateCharge {
-			b.ChargeDuration = 0
-			b.State = BossStateIdle
+			b.endAttack()
 		}
 	}
 }
@@ -532,18 +1058,85 @@ func (b *Boss) TakeDamage(damage int) bool {
 	if b.Health <= 0 {
 		b.Health = 0
 		b.Die()
-		return true
 	}
 
-	// Pequeño knockback
-	if b.Target != nil {
-		direction := b.Position.Sub(b.Target.Position).Normalize()
-		b.Velocity.X += direction.X * 2
+	return true
+}
+
+// ============================================================================
+// DAMAGEABLE (pipeline de daño)
+// ============================================================================
+
+// GetActorID identifica al boss en eventos y en el registro de golpes
+func (b *Boss) GetActorID() combat.ActorID {
+	return b.ID
+}
+
+// GetPosition retorna la posición del boss
+func (b *Boss) GetPosition() utils.Vector2 {
+	return b.Position
+}
+
+// CanReceiveHit es false durante la transición de fase o si está muerto
+func (b *Boss) CanReceiveHit() bool {
+	return !b.IsInvulnerable && b.State != BossStateTransition && b.State != BossStateDead
+}
+
+// GetDefenses retorna las defensas de la fase actual. La vulnerabilidad junta
+// la postura rota y el estado Vulnerable, así el desglose refleja el daño real.
+func (b *Boss) GetDefenses() combat.Defenses {
+	defenses := b.config.PhaseDefenses[b.Phase]
+
+	multiplier := b.Status.DamageTakenMultiplier()
+	if b.poiseBroken {
+		multiplier *= 1.0 + b.config.StaggerDamageBonus
+	}
+	defenses.Vulnerability = multiplier - 1.0
+	return defenses
+}
+
+// GetStatusEffects retorna los efectos de estado (para efectos al golpear)
+func (b *Boss) GetStatusEffects() *combat.StatusEffects {
+	return b.Status
+}
+
+// ReceiveDamage aplica el daño final y un knockback horizontal (el boss es pesado)
+func (b *Boss) ReceiveDamage(damage int, knockback utils.Vector2) bool {
+	if !b.TakeDamage(damage) {
+		return false
 	}
 
+	if b.State != BossStateDead {
+		b.Velocity.X += knockback.X
+	}
 	return true
 }
 
+// Stagger aturde al boss y cancela el ataque en curso (por ejemplo, tras un parry)
+func (b *Boss) Stagger(duration int) {
+	if b.State == BossStateDead || b.State == BossStateTransition {
+		return
+	}
+
+	b.State = BossStateStunned
+	b.StunDuration = duration
+	b.StunTimeLeft = duration
+	b.Velocity.X = 0
+
+	// Cancelar ataques en curso
+	b.Attack.Stop()
+	b.RoarDuration = 0
+	b.WantsToShoot = false
+	b.WantsShockwave = false
+	b.NextAction = BossStateIdle
+	b.EndChain()
+}
+
+// OnParried aturde al boss cuando el jugador le hace parry
+func (b *Boss) OnParried() {
+	b.Stagger(b.config.ParryStaggerTime)
+}
+
 // Die mata al boss
 func (b *Boss) Die() {
 	b.State = BossStateDead
@@ -564,6 +1157,18 @@ func (b *Boss) Draw(screen *ebiten.Image) {
 		bodyColor = color.RGBA{255, 100, 0, 255}
 	case BossStateRoar:
 		bodyColor = color.RGBA{255, 255, 0, 255}
+	case BossStateLeap:
+		bodyColor = color.RGBA{180, 80, 255, 255}
+	case BossStateGuard:
+		bodyColor = color.RGBA{90, 110, 160, 255}
+	case BossStateCounter:
+		bodyColor = color.RGBA{255, 40, 120, 255}
+	case BossStateSummon:
+		bodyColor = color.RGBA{120, 255, 120, 255}
+	case BossStateSwoop:
+		bodyColor = color.RGBA{255, 60, 160, 255}
+	case BossStateVolley:
+		bodyColor = color.RGBA{255, 200, 120, 255}
 	case BossStateStunned:
 		bodyColor = color.RGBA{100, 100, 255, 255}
 	case BossStateTransition:
@@ -572,6 +1177,9 @@ func (b *Boss) Draw(screen *ebiten.Image) {
 			bodyColor = color.RGBA{255, 255, 255, 255}
 		}
 	}
+	if b.telegraphFlashing() {
+		bodyColor = color.RGBA{255, 255, 255, 255}
+	}
 
 	// Dibujar cuerpo
 	vector.DrawFilledRect(
@@ -596,6 +1204,14 @@ func (b *Boss) Draw(screen *ebiten.Image) {
 		false,
 	)
 
+	// Aviso del próximo movimiento y punto de caída del leap
+	b.drawTelegraph(screen)
+	b.drawLeapMarker(screen)
+
+	// Escudo de la guardia y destello de armadura
+	b.drawGuard(screen)
+	b.drawArmorFlash(screen)
+
 	// Indicador de dirección
 	b.drawDirectionIndicator(screen)
 
@@ -649,10 +1265,37 @@ func (b *Boss) drawHealthBar(screen *ebiten.Image) {
 
 	// Borde
 	vector.StrokeRect(screen, barX, barY, barWidth, barHeight, 1, color.White, false)
+
+	// Postura
+	b.drawPoiseBar(screen, barX, barY+barHeight+1, barWidth)
 }
 
 // UpdateColor actualiza el color del boss según su fase actual
 func (b *Boss) UpdateColor() {
+	if c, ok := b.config.PhaseColors[b.Phase]; ok {
+		b.bodyColor = c
+		return
+	}
 	r, g, bl := b.Phase.GetColor()
 	b.bodyColor = color.RGBA{r, g, bl, 255}
 }
+
+// Name retorna el nombre del boss (HUD y menú)
+func (b *Boss) Name() string {
+	return b.config.Name
+}
+
+// Kind retorna el tipo de boss
+func (b *Boss) Kind() BossKind {
+	return b.config.Kind
+}
+
+// OnPogoStreak responde a tres pogos seguidos con el movimiento de su configuración
+func (b *Boss) OnPogoStreak() {
+	move := b.config.PogoCounter
+	if move != BossStateIdle && b.moveReady(move) {
+		b.NextAction = move
+		b.DecisionTimer = 0
+	}
+	b.ConsecutivePogos = 0
+}
@@ -1,19 +1,44 @@
 package entities
 
-import "github.com/MarcosBrindis/boss-arena-go/internal/utils"
+import (
+	"github.com/MarcosBrindis/boss-arena-go/internal/ai"
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+)
 
 // updateAI actualiza la inteligencia artificial del boss
 func (b *Boss) updateAI() {
 	// No procesar IA en estos estados
-	if b.State == BossStateSlam ||
+	if b.State == BossStateAttacking ||
+		b.State == BossStateSlam ||
 		b.State == BossStateCharge ||
 		b.State == BossStateRoar ||
 		b.State == BossStateStunned ||
 		b.State == BossStateTransition ||
+		b.State == BossStateWindUp ||
+		b.State == BossStateLeap ||
+		b.State == BossStateBackstep ||
+		b.State == BossStateGuard ||
+		b.State == BossStateCounter ||
+		b.State == BossStateSummon ||
+		b.State == BossStateSwoop ||
+		b.State == BossStateVolley ||
+		b.State == BossStateEscape ||
 		b.State == BossStateDead {
 		return
 	}
 
+	// En el aire o esquivando no decide nada hasta terminar
+	if b.Leap.IsActive() || b.IsDodging() {
+		return
+	}
+
+	// Aturdido por un efecto de estado: no decide ni se mueve
+	if b.Status.Has(combat.StatusStun) {
+		b.Velocity.X = 0
+		return
+	}
+
 	// No hay objetivo
 	if b.Target == nil {
 		return
@@ -22,6 +47,12 @@ func (b *Boss) updateAI() {
 	// Actualizar dirección hacia el jugador
 	b.updateFacingDirection()
 
+	// Cadena en curso: el siguiente golpe reemplaza a la decisión
+	if b.Chain.IsActive() && b.NextAction == BossStateIdle {
+		b.advanceChain()
+		return
+	}
+
 	// Tomar decisiones cada cierto tiempo
 	if b.DecisionTimer <= 0 {
 		b.makeDecision()
@@ -41,91 +72,390 @@ func (b *Boss) updateFacingDirection() {
 	b.FacingRight = b.Target.Position.X > b.Position.X
 }
 
-// makeDecision decide la próxima acción del boss
-func (b *Boss) makeDecision() {
-	if b.Target == nil {
-		return
-	}
+// ============================================================================
+// IA DE UTILIDAD
+// ============================================================================
 
-	// Distancia 2D completa
-	distanceToPlayer := b.Position.Distance(b.Target.Position)
+// bossMoveStates traduce los movimientos de la IA a estados del boss
+var bossMoveStates = map[string]BossState{
+	"attack":   BossStateAttacking,
+	"slam":     BossStateSlam,
+	"charge":   BossStateCharge,
+	"roar":     BossStateRoar,
+	"shoot":    BossStateShooting,
+	"leap":     BossStateLeap,
+	"escape":   BossStateEscape,
+	"backstep": BossStateBackstep,
+	"guard":    BossStateGuard,
+	"summon":   BossStateSummon,
+	"swoop":    BossStateSwoop,
+	"volley":   BossStateVolley,
+	"walk":     BossStateWalking,
+	"idle":     BossStateIdle,
+}
 
-	// Distancia solo horizontal
-	horizontalDistance := utils.Abs(b.Position.X - b.Target.Position.X)
+// newBossBrain crea la IA de utilidad con los movimientos de su tipo de boss
+func newBossBrain(b *Boss) *ai.UtilitySystem {
+	moves := groundBossMoves(b)
+	if b.config.Flying {
+		moves = flyingBossMoves(b)
+	}
+	return ai.NewUtilitySystem(ai.DefaultUtilityConfig(), moves, b.rng)
+}
 
-	// Lista de ataques disponibles
-	availableAttacks := []BossState{}
+// groundBossMoves retorna los movimientos del Titán.
+// Los pesos por fase (Fase 1, 2, 3) hacen que en Fase 3 prefiera los especiales.
+func groundBossMoves(b *Boss) []ai.UtilityMove {
+	cfg := &b.config
 
-	// ========================================================================
-	// FASE 2 y 3: El boss puede disparar proyectiles (NUEVO)
-	// ========================================================================
-	if (b.Phase == Phase2 || b.Phase == Phase3) && b.ShootCooldown == 0 {
-		// Si el jugador está lejos, disparar
-		if horizontalDistance > 250 && horizontalDistance < 600 {
-			availableAttacks = append(availableAttacks, BossStateShooting)
-		}
+	return []ai.UtilityMove{
+		{
+			Name: "attack",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return b.AttackCooldown == 0 && ctx.Distance <= cfg.AttackRange
+			},
+			Considerations: []ai.Consideration{
+				{Name: "cerca", Weight: 2, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.InverseLinear(ctx.Distance, 0, cfg.AttackRange)
+				}},
+				{Name: "en suelo", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Bool(!ctx.TargetAirborne)
+				}},
+				{Name: "sin stamina", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.InverseLinear(ctx.TargetStamina, 0.2, 1)
+				}},
+				{Name: "acorralado", Weight: 0.5, Score: func(ctx *ai.UtilityContext) float64 {
+					return ctx.TargetCornered
+				}},
+			},
+			Counters: []ai.HabitCounter{
+				{Habit: ai.HabitWallCamping, Strength: 0.5},
+			},
+			PhaseWeights: []float64{1.0, 0.9, 0.8},
+		},
+		{
+			Name: "slam",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return b.SlamCooldown == 0 && ctx.Distance <= cfg.SlamRadius
+			},
+			Considerations: []ai.Consideration{
+				// Las ondas de choque solo alcanzan a quien está en el suelo
+				{Name: "en suelo", Weight: 2, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Bool(!ctx.TargetAirborne)
+				}},
+				{Name: "en radio", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.InverseLinear(ctx.Distance, cfg.SlamRadius*0.5, cfg.SlamRadius)
+				}},
+				// Sacarse al jugador de encima cuando el boss está contra la pared
+				{Name: "boss acorralado", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ctx.Cornered
+				}},
+			},
+			Counters: []ai.HabitCounter{
+				// Las ondas salen justo cuando termina el dash
+				{Habit: ai.HabitDashThrough, Strength: 0.8},
+			},
+			PhaseWeights: []float64{0.8, 1.0, 1.3},
+		},
+		{
+			Name: "charge",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return b.ChargeCooldown == 0 && ctx.HorizontalDistance > 150 && ctx.HorizontalDistance < 400
+			},
+			Considerations: []ai.Consideration{
+				{Name: "media distancia", Weight: 2, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Band(ctx.HorizontalDistance, 200, 350, 100)
+				}},
+				{Name: "acorralado", Weight: 1.5, Score: func(ctx *ai.UtilityContext) float64 {
+					return ctx.TargetCornered
+				}},
+				{Name: "en suelo", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Bool(!ctx.TargetAirborne)
+				}},
+			},
+			Counters: []ai.HabitCounter{
+				{Habit: ai.HabitRangedShots, Strength: 0.8},
+				{Habit: ai.HabitWallCamping, Strength: 0.8},
+				// Si el jugador ya atraviesa la carga, usarla menos
+				{Habit: ai.HabitDashThrough, Strength: -1.0},
+			},
+			PhaseWeights: []float64{0.7, 1.0, 1.3},
+		},
+		{
+			Name: "roar",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return b.RoarCooldown == 0 && ctx.Distance <= cfg.RoarRange
+			},
+			Considerations: []ai.Consideration{
+				{Name: "cerca", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.InverseLinear(ctx.Distance, cfg.RoarRange*0.5, cfg.RoarRange)
+				}},
+				{Name: "en suelo", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Bool(!ctx.TargetAirborne)
+				}},
+				// Con poca vida necesita espacio para respirar
+				{Name: "boss herido", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.InverseLinear(ctx.HealthPercent, 0.2, 1)
+				}},
+			},
+			Counters: []ai.HabitCounter{
+				// El rugido alcanza al jugador que rebota encima
+				{Habit: ai.HabitPogo, Strength: 1.0},
+				{Habit: ai.HabitWallCamping, Strength: 0.3},
+			},
+			PhaseWeights: []float64{0.6, 1.0, 1.2},
+		},
+		{
+			Name: "shoot",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return ctx.Phase >= int(Phase2) && b.ShootCooldown == 0 &&
+					ctx.HorizontalDistance > 250 && ctx.HorizontalDistance < 600
+			},
+			Considerations: []ai.Consideration{
+				{Name: "lejos", Weight: 2, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Linear(ctx.HorizontalDistance, 250, 500)
+				}},
+				// En el aire no puede esquivar con dash tan fácil
+				{Name: "en aire", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Bool(ctx.TargetAirborne)
+				}},
+			},
+			Counters: []ai.HabitCounter{
+				{Habit: ai.HabitWallCamping, Strength: 0.4},
+			},
+			PhaseWeights: []float64{0, 1.0, 1.1},
+		},
+		{
+			Name: "leap",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return b.LeapCooldown == 0 && b.IsOnGround &&
+					ctx.HorizontalDistance > 200 && ctx.HorizontalDistance < 700
+			},
+			Considerations: []ai.Consideration{
+				{Name: "lejos", Weight: 2, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Linear(ctx.HorizontalDistance, 200, 500)
+				}},
+				// En el suelo la caída es fácil de predecir
+				{Name: "en suelo", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Bool(!ctx.TargetAirborne)
+				}},
+				{Name: "acorralado", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ctx.TargetCornered
+				}},
+			},
+			Counters: []ai.HabitCounter{
+				{Habit: ai.HabitRangedShots, Strength: 0.8},
+				{Habit: ai.HabitWallCamping, Strength: 0.6},
+			},
+			PhaseWeights: []float64{0.6, 1.0, 1.2},
+		},
+		{
+			Name: "escape",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return b.EscapeCooldown == 0 && b.IsOnGround &&
+					ctx.Cornered > 0.8 && ctx.HorizontalDistance < 200
+			},
+			Considerations: []ai.Consideration{
+				{Name: "boss acorralado", Weight: 2, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Linear(ctx.Cornered, 0.8, 1)
+				}},
+				{Name: "jugador encima", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.InverseLinear(ctx.HorizontalDistance, 0, 200)
+				}},
+				{Name: "boss herido", Weight: 0.5, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.InverseLinear(ctx.HealthPercent, 0.2, 1)
+				}},
+			},
+			PhaseWeights: []float64{0.8, 1.0, 1.2},
+		},
+		{
+			Name: "backstep",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return b.BackstepCooldown == 0 && b.IsOnGround &&
+					ctx.HorizontalDistance < 120 && b.backstepRoom() > cfg.BackstepSpeed*float64(cfg.BackstepFrames)*0.5
+			},
+			Considerations: []ai.Consideration{
+				// Retrocede cuando el jugador encadena golpes encima
+				{Name: "tras combo", Weight: 2, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Linear(float64(ctx.TargetCombo), 1, 4)
+				}},
+				{Name: "muy cerca", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.InverseLinear(ctx.HorizontalDistance, 40, 120)
+				}},
+				{Name: "espacio detrás", Weight: 0.5, Score: func(ctx *ai.UtilityContext) float64 {
+					return 1 - ctx.Cornered
+				}},
+			},
+			PhaseWeights: []float64{1.0, 1.0, 0.8},
+		},
+		{
+			Name: "guard",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return b.GuardCooldown == 0 && b.IsOnGround && ctx.Distance < 200
+			},
+			Considerations: []ai.Consideration{
+				{Name: "jugador atacando", Weight: 2, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Bool(ctx.TargetAttacking)
+				}},
+				{Name: "en suelo", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Bool(!ctx.TargetAirborne)
+				}},
+				{Name: "boss herido", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.InverseLinear(ctx.HealthPercent, 0.2, 1)
+				}},
+			},
+			// En furia (Fase 3) prefiere atacar a cubrirse
+			PhaseWeights: []float64{1.0, 0.9, 0.6},
+		},
+		{
+			Name: "summon",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return ctx.Phase >= int(Phase2) && b.SummonCooldown == 0 && ctx.Minions < cfg.MaxMinions
+			},
+			Considerations: []ai.Consideration{
+				{Name: "pocos minions", Weight: 2, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.InverseLinear(float64(ctx.Minions), 0, float64(cfg.MaxMinions))
+				}},
+				// Invoca cuando tiene espacio para que no lo castiguen
+				{Name: "lejos", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Linear(ctx.HorizontalDistance, 150, 400)
+				}},
+			},
+			Counters: []ai.HabitCounter{
+				// Los minions presionan al que dispara desde lejos o se queda en la pared
+				{Habit: ai.HabitRangedShots, Strength: 0.5},
+				{Habit: ai.HabitWallCamping, Strength: 0.4},
+			},
+			PhaseWeights: []float64{0, 0.8, 1.0},
+		},
+		{
+			Name: "walk",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return ctx.Distance > cfg.AttackRange
+			},
+			Considerations: []ai.Consideration{
+				{Name: "lejos", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Linear(ctx.Distance, cfg.AttackRange, 400)
+				}},
+			},
+			Counters: []ai.HabitCounter{
+				{Habit: ai.HabitRangedShots, Strength: 0.6},
+			},
+			PhaseWeights: []float64{0.5, 0.5, 0.5},
+			Repeatable:   true,
+		},
+		{
+			Name: "idle",
+			Considerations: []ai.Consideration{
+				{Name: "base", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return 0.1
+				}},
+			},
+			Repeatable: true,
+		},
 	}
+}
 
-	// Ataque básico siempre disponible si está en rango
-	if distanceToPlayer <= b.config.AttackRange && b.AttackCooldown == 0 {
-		availableAttacks = append(availableAttacks, BossStateAttacking)
+// buildUtilityContext arma el contexto con el que la IA puntúa los movimientos
+func (b *Boss) buildUtilityContext() ai.UtilityContext {
+	ctx := ai.UtilityContext{
+		Phase:              int(b.Phase),
+		Distance:           b.Position.Distance(b.Target.Position),
+		HorizontalDistance: utils.Abs(b.Position.X - b.Target.Position.X),
+		TargetAirborne:     !b.Target.IsOnGround,
+		HealthPercent:      float64(b.Health) / float64(b.MaxHealth),
+		Cornered:           b.cornerProximity(b.Position.X),
+		TargetCornered:     b.cornerProximity(b.Target.Position.X),
+		Habits:             b.habits.Tendencies(),
+		TargetAttacking:    b.Target.Attack.IsRunning(),
+		TargetCombo:        b.Target.ComboCount,
+		Minions:            b.MinionCount,
 	}
+	if b.Target.MaxStamina > 0 {
+		ctx.TargetStamina = b.Target.Stamina / b.Target.MaxStamina
+	}
+	return ctx
+}
 
-	// Slam disponible
-	if b.SlamCooldown == 0 && distanceToPlayer <= b.config.SlamRadius {
-		availableAttacks = append(availableAttacks, BossStateSlam)
+// cornerProximity retorna qué tan cerca de una pared está x (0 = centro, 1 = pared)
+func (b *Boss) cornerProximity(x float64) float64 {
+	bounds := b.arena.GetBounds()
+	half := bounds.Width / 2
+	if half <= 0 {
+		return 0
 	}
 
-	// Charge disponible (si el jugador está lejos)
-	if b.ChargeCooldown == 0 && horizontalDistance > 150 && horizontalDistance < 400 {
-		availableAttacks = append(availableAttacks, BossStateCharge)
+	toWall := utils.Min(x-bounds.X, bounds.X+bounds.Width-x)
+	return ai.InverseLinear(toWall, 0, half)
+}
+
+// makeDecision decide la próxima acción del boss puntuando cada movimiento
+func (b *Boss) makeDecision() {
+	if b.Target == nil {
+		return
 	}
 
-	// Roar disponible
-	if b.RoarCooldown == 0 && distanceToPlayer <= b.config.RoarRange {
-		availableAttacks = append(availableAttacks, BossStateRoar)
+	ctx := b.buildUtilityContext()
+
+	// Quedarse pegado a una pared es un hábito que el boss observa por sí mismo
+	if ctx.TargetCornered > 0.8 && !ctx.TargetAirborne {
+		b.habits.Observe(ai.HabitWallCamping, 1)
 	}
 
-	// Elegir ataque según fase
-	if len(availableAttacks) > 0 {
-		// Fase 3 = más agresivo (prioriza ataques especiales)
-		if b.Phase == Phase3 && len(availableAttacks) > 1 {
-			// Elegir el ataque más fuerte disponible
-			for _, attack := range availableAttacks {
-				if attack == BossStateSlam || attack == BossStateCharge || attack == BossStateRoar {
-					b.NextAction = attack
-					return
-				}
-			}
-		}
+	move := b.brain.Decide(&ctx)
 
-		// Elegir ataque aleatorio
-		b.NextAction = availableAttacks[b.rng.Intn(len(availableAttacks))]
-	} else {
-		// No hay ataques disponibles, acercarse al jugador
-		if distanceToPlayer > b.config.AttackRange {
-			b.NextAction = BossStateWalking
-		} else {
-			b.NextAction = BossStateIdle
-		}
+	state, ok := bossMoveStates[move]
+	if !ok {
+		state = BossStateIdle
 	}
+	b.NextAction = state
+
+	// El movimiento elegido puede abrir una cadena de la fase
+	b.Chain = ChainState{}
+	b.shootAim = 0
+	b.tryStartChain(state)
+}
+
+// GetLastDecision retorna la última evaluación de la IA (para el overlay de debug)
+func (b *Boss) GetLastDecision() ai.UtilityDecision {
+	return b.brain.LastDecision()
+}
+
+// ObserveHabit registra un hábito del jugador que detectó el juego
+func (b *Boss) ObserveHabit(habit ai.Habit, amount float64) {
+	b.habits.Observe(habit, amount)
+}
+
+// GetHabits retorna el modelo de hábitos del jugador (resumen final)
+func (b *Boss) GetHabits() *ai.HabitModel {
+	return b.habits
+}
+
+// GetHabitCounters retorna los movimientos con los que el boss castiga un hábito
+func (b *Boss) GetHabitCounters(habit ai.Habit) []string {
+	return b.brain.CountersFor(habit)
+}
+
+// ResetAI borra la memoria de la IA (al reiniciar la pelea)
+func (b *Boss) ResetAI() {
+	b.brain.Forget()
+	b.habits.Reset()
+	b.NextAction = BossStateIdle
+	b.DecisionTimer = 0
+	b.Chain = ChainState{}
+	b.shootAim = 0
 }
 
 // executeAction ejecuta la acción decidida
 func (b *Boss) executeAction() {
 	switch b.NextAction {
 	case BossStateWalking:
-		b.walkTowardsPlayer()
-	case BossStateAttacking:
-		b.performBasicAttack()
-	case BossStateSlam:
-		b.performSlam()
-	case BossStateCharge:
-		b.performCharge()
-	case BossStateRoar:
-		b.performRoar()
-	case BossStateShooting:
-		b.performShoot()
+		b.locomotion.approach(b)
+	case BossStateAttacking, BossStateSlam, BossStateCharge, BossStateRoar, BossStateShooting,
+		BossStateLeap, BossStateEscape, BossStateBackstep, BossStateGuard, BossStateSummon,
+		BossStateSwoop, BossStateVolley:
+		// Todos los ataques empiezan con su aviso (escape y defensas no tienen)
+		b.startTelegraph(b.NextAction)
 	}
 }
 
@@ -141,12 +471,10 @@ func (b *Boss) walkTowardsPlayer() {
 	}
 
 	// Velocidad aumenta con las fases
-	speed := b.config.WalkSpeed
-	if b.Phase == Phase2 {
-		speed *= 1.3
-	} else if b.Phase == Phase3 {
-		speed *= 1.6
-	}
+	speed := b.config.WalkSpeed * b.phaseSpeedMultiplier()
+
+	// Slow reduce la velocidad
+	speed *= b.Status.SpeedMultiplier()
 
 	b.Velocity.X = direction * speed
 }
@@ -0,0 +1,82 @@
+// internal/entities/boss_armor.go
+package entities
+
+import (
+	"image/color"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// ============================================================================
+// ARMADURA E INTERRUPCIONES
+// ============================================================================
+
+// currentArmor retorna la armadura del movimiento en curso.
+// El aviso y el salto del leap usan la armadura del movimiento que preparan.
+func (b *Boss) currentArmor() combat.ArmorLevel {
+	move := b.State
+	switch {
+	case b.State == BossStateWindUp && b.Telegraph.IsActive():
+		move = b.Telegraph.Move
+	case b.Leap.Kind == LeapAttack:
+		move = BossStateLeap
+	}
+	return b.config.MoveArmor[move]
+}
+
+// ResolveInterrupt corta los movimientos sin armadura con golpes que interrumpen
+// (pogo, disparo cargado). Los movimientos con armadura siguen y destellan
+// (implementa combat.Interruptible).
+func (b *Boss) ResolveInterrupt(hit *combat.Hit) combat.InterruptResult {
+	switch b.State {
+	case BossStateStunned, BossStateTransition, BossStateDead:
+		return combat.InterruptNone
+	}
+
+	if b.currentArmor() != combat.ArmorNone {
+		b.ArmorFlash = b.config.ArmorFlashFrames
+		return combat.InterruptArmored
+	}
+
+	// Tras un flinch hay un respiro para que no lo encadenen sin parar
+	if !hit.Interrupts || b.FlinchCooldown > 0 {
+		return combat.InterruptNone
+	}
+
+	b.Stagger(b.config.FlinchFrames)
+	b.FlinchCooldown = b.config.FlinchCooldown
+	return combat.InterruptFlinched
+}
+
+// updateArmor avanza el destello de armadura y el respiro entre flinches (llamar en updateTimers)
+func (b *Boss) updateArmor() {
+	if b.ArmorFlash > 0 {
+		b.ArmorFlash--
+	}
+	if b.FlinchCooldown > 0 {
+		b.FlinchCooldown--
+	}
+}
+
+// drawArmorFlash dibuja el contorno dorado cuando la armadura absorbe un golpe
+func (b *Boss) drawArmorFlash(screen *ebiten.Image) {
+	if b.ArmorFlash <= 0 || b.config.ArmorFlashFrames <= 0 {
+		return
+	}
+
+	hitbox := b.GetHitbox()
+	alpha := uint8(255 * b.ArmorFlash / b.config.ArmorFlashFrames)
+	grow := float32(b.config.ArmorFlashFrames-b.ArmorFlash) * 0.8
+	vector.StrokeRect(
+		screen,
+		float32(hitbox.X)-4-grow,
+		float32(hitbox.Y)-4-grow,
+		float32(hitbox.Width)+8+grow*2,
+		float32(hitbox.Height)+8+grow*2,
+		4,
+		color.RGBA{255, 215, 0, alpha},
+		false,
+	)
+}
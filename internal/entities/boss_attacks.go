@@ -2,6 +2,7 @@
 package entities
 
 import (
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
 	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
 )
 
@@ -12,8 +13,8 @@ func (b *Boss) performBasicAttack() {
 	}
 
 	b.State = BossStateAttacking
+	b.Attack.Start(&b.config.BasicAttack)
 	b.AttackCooldown = b.config.AttackCooldown
-	b.AttackDelay = 15 // 0.25 segundos antes de hacer daño
 
 	// Pequeño impulso hacia adelante
 	if b.FacingRight {
@@ -30,11 +31,61 @@ func (b *Boss) performSlam() {
 	}
 
 	b.State = BossStateSlam
-	b.SlamDuration = b.config.SlamDuration
+	b.Attack.Start(&b.config.SlamAttack)
 	b.SlamCooldown = b.config.SlamCooldown
 	b.Velocity = utils.Zero()
+	b.WantsShockwave = false // Se activa en el frame de impacto
+}
+
+// GetShockwaveSpeed retorna la velocidad de las ondas de choque según la fase
+func (b *Boss) GetShockwaveSpeed() float64 {
+	speed := b.config.ShockwaveSpeed
+	if b.Phase == Phase2 {
+		speed *= 1.3
+	} else if b.Phase == Phase3 {
+		speed *= 1.6
+	}
+	return speed
+}
+
+// GetShockwaveDamage retorna el daño de cada onda de choque
+func (b *Boss) GetShockwaveDamage() int {
+	return b.config.ShockwaveDamage
+}
 
-	// TODO: En el próximo módulo, crear shockwave
+// GetShockwaveLifetime retorna cuántos frames viaja cada onda
+func (b *Boss) GetShockwaveLifetime() int {
+	return b.config.ShockwaveLifetime
+}
+
+// shockwaveReach retorna hasta dónde llegan las ondas a cada lado (limitado por las paredes)
+func (b *Boss) shockwaveReach() (left, right float64) {
+	distance := b.GetShockwaveSpeed() * float64(b.config.ShockwaveLifetime)
+	left = b.clampToArena(b.Position.X - b.Size.X/2 - distance)
+	right = b.clampToArena(b.Position.X + b.Size.X/2 + distance)
+	return left, right
+}
+
+// GetShockwaveDamageType retorna el tipo de daño de las ondas en la fase actual
+func (b *Boss) GetShockwaveDamageType() combat.DamageType {
+	if damageType, ok := b.config.ShockwaveDamageType[b.Phase]; ok {
+		return damageType
+	}
+	return combat.DamagePhysical
+}
+
+// GetShockwaveSplitFrames retorna tras cuántos frames se divide la onda (0 = no se divide)
+func (b *Boss) GetShockwaveSplitFrames() int {
+	// Solo en Fase 3 la onda se vuelve doble
+	if b.Phase != Phase3 {
+		return 0
+	}
+	return b.config.ShockwaveSplitFrames
+}
+
+// GetGroundY retorna la Y de los pies del boss
+func (b *Boss) GetGroundY() float64 {
+	return b.Position.Y + b.Size.Y/2
 }
 
 // performCharge realiza el ataque Charge
@@ -44,7 +95,7 @@ func (b *Boss) performCharge() {
 	}
 
 	b.State = BossStateCharge
-	b.ChargeDuration = b.config.ChargeDuration
+	b.Attack.Start(&b.config.ChargeAttack)
 	b.ChargeCooldown = b.config.ChargeCooldown
 
 	// Dirección hacia el jugador
@@ -58,6 +109,9 @@ func (b *Boss) performCharge() {
 	} else if b.Phase == Phase3 {
 		b.ChargeSpeed *= 1.5
 	}
+
+	// Slow reduce la velocidad de la carga
+	b.ChargeSpeed *= b.Status.SpeedMultiplier()
 }
 
 // performRoar realiza el ataque Roar
@@ -86,65 +140,58 @@ func (b *Boss) stunPlayer() {
 		return
 	}
 
-	// TODO: Implementar en el Módulo 6 (Combat System)
-	// Por ahora solo guardamos que debe aplicarse
+	b.Target.ApplyStun(b.config.RoarStunTime)
 }
 
-// GetAttackHitbox retorna el hitbox del ataque actual
-func (b *Boss) GetAttackHitbox() *utils.Rectangle {
-	if b.State != BossStateAttacking || b.AttackDelay > 0 {
-		return nil
+// endAttack termina el ataque en curso y vuelve a Idle
+func (b *Boss) endAttack() {
+	b.Attack.Stop()
+
+	switch b.State {
+	case BossStateCharge:
+		b.Velocity.X = 0
+		b.State = BossStateIdle
+	case BossStateAttacking, BossStateSlam, BossStateCounter:
+		b.State = BossStateIdle
+	case BossStateLeap:
+		b.endLeapImpact()
+	case BossStateSwoop:
+		b.endSwoop()
 	}
+}
 
-	// Hitbox extendido en la dirección que mira
-	attackReach := 50.0
-	attackWidth := 60.0
-	attackHeight := 80.0
+// GetCurrentAttack retorna la definición del ataque en curso (nil si no ataca)
+func (b *Boss) GetCurrentAttack() *combat.AttackDefinition {
+	return b.Attack.Def
+}
 
-	var attackX float64
-	if b.FacingRight {
-		attackX = b.Position.X + b.Size.X/2
-	} else {
-		attackX = b.Position.X - b.Size.X/2 - attackWidth
+// GetAttackHitbox retorna el hitbox del ataque básico (solo en frames activos)
+func (b *Boss) GetAttackHitbox() *utils.Rectangle {
+	if b.State != BossStateAttacking || !b.Attack.Is(&b.config.BasicAttack) {
+		return nil
 	}
 
-	rect := utils.NewRectangle(
-		attackX,
-		b.Position.Y-attackHeight/2,
-		attackWidth+attackReach,
-		attackHeight,
-	)
-
-	return &rect
+	return b.Attack.Hitbox(b.Position, b.FacingRight)
 }
 
 // GetSlamHitbox retorna el hitbox del slam
 func (b *Boss) GetSlamHitbox() *utils.Rectangle {
-	if b.State != BossStateSlam || b.SlamDuration > 15 {
+	if b.State != BossStateSlam || !b.Attack.Is(&b.config.SlamAttack) {
 		return nil
 	}
 
-	// Área circular alrededor del boss
-	rect := utils.NewRectangle(
-		b.Position.X-b.config.SlamRadius,
-		b.Position.Y-b.config.SlamRadius,
-		b.config.SlamRadius*2,
-		b.config.SlamRadius*2,
-	)
-
-	return &rect
+	return b.Attack.Hitbox(b.Position, b.FacingRight)
 }
 
 // GetChargeHitbox retorna el hitbox del charge
 func (b *Boss) GetChargeHitbox() *utils.Rectangle {
-	if b.State != BossStateCharge {
+	if b.State != BossStateCharge || !b.Attack.Is(&b.config.ChargeAttack) {
 		return nil
 	}
 
 	// NOTA: El jugador puede hacer pogo sobre el boss durante charge
 	// Esto es intencional - alto riesgo, alta recompensa
-	hitbox := b.GetHitbox()
-	return &hitbox
+	return b.Attack.Hitbox(b.Position, b.FacingRight)
 }
 
 // performShoot realiza un disparo (NUEVO - Módulo 7)
@@ -154,7 +201,7 @@ func (b *Boss) performShoot() {
 	}
 
 	b.State = BossStateShooting
-	b.ShootDelay = 20    // 0.33 segundos antes de disparar
+	b.ShootDelay = 0     // El aviso ya hizo de anticipación
 	b.ShootCooldown = 90 // 1.5 segundos de cooldown
 
 	// Fase 3: Dispara misiles homing
@@ -177,6 +224,6 @@ func (b *Boss) GetShootDirection() utils.Vector2 {
 		return utils.NewVector2(-1, 0)
 	}
 
-	// Apuntar hacia el jugador
-	return b.Target.Position.Sub(b.Position).Normalize()
+	// Apuntar hacia el jugador (desviado en los abanicos)
+	return b.Target.Position.Sub(b.Position).Normalize().Rotate(b.shootAim)
 }
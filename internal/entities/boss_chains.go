@@ -0,0 +1,198 @@
+// internal/entities/boss_chains.go
+package entities
+
+import "fmt"
+
+// ============================================================================
+// CADENAS DE ATAQUES (COMBOS DEL BOSS)
+// ============================================================================
+
+// ChainCondition decide si un paso de la cadena se ejecuta según el anterior
+type ChainCondition int
+
+const (
+	ChainAlways ChainCondition = iota // Siempre
+	ChainOnHit                        // Solo si el paso anterior conectó
+	ChainOnMiss                       // Solo si el paso anterior falló
+)
+
+// ChainStep es un movimiento dentro de una cadena
+type ChainStep struct {
+	Move BossState
+	When ChainCondition
+	Else BossState // Si no se cumple la condición: este movimiento y fin (Idle = terminar)
+	Aim  float64   // Desvío del disparo en radianes (abanicos)
+}
+
+// AttackChain es una secuencia de movimientos escrita a mano.
+// Empieza cuando la IA elige el primer movimiento.
+type AttackChain struct {
+	Name  string
+	Steps []ChainStep
+}
+
+// ChainState es la cadena en curso
+type ChainState struct {
+	Chain *AttackChain
+	Step  int  // Paso que se está ejecutando
+	Gap   int  // Frames hasta el siguiente paso (se puede interrumpir)
+	Hit   bool // El paso actual conectó
+	Final bool // Se ejecutó un Else: termina al acabar este movimiento
+}
+
+// IsActive retorna true si hay una cadena en curso
+func (cs *ChainState) IsActive() bool {
+	return cs.Chain != nil
+}
+
+// String retorna la cadena y el paso actual (debug)
+func (cs *ChainState) String() string {
+	if cs.Chain == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%s (%d/%d)", cs.Chain.Name, cs.Step+1, len(cs.Chain.Steps))
+}
+
+// tryStartChain empieza, con la probabilidad de la fase, una cadena que abre con move
+func (b *Boss) tryStartChain(move BossState) {
+	chance := b.config.ChainChance[b.Phase]
+	if chance <= 0 || b.rng.Float64() >= chance {
+		return
+	}
+
+	var candidates []*AttackChain
+	chains := b.config.Chains[b.Phase]
+	for i := range chains {
+		if len(chains[i].Steps) > 1 && chains[i].Steps[0].Move == move {
+			candidates = append(candidates, &chains[i])
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	chain := candidates[b.rng.Intn(len(candidates))]
+	b.Chain = ChainState{Chain: chain}
+	b.shootAim = chain.Steps[0].Aim
+}
+
+// nextChainStep retorna el siguiente movimiento según si el paso actual conectó
+func (b *Boss) nextChainStep() (ChainStep, bool) {
+	if b.Chain.Final {
+		return ChainStep{}, false
+	}
+
+	next := b.Chain.Step + 1
+	if next >= len(b.Chain.Chain.Steps) {
+		return ChainStep{}, false
+	}
+
+	step := b.Chain.Chain.Steps[next]
+	switch {
+	case step.When == ChainOnHit && !b.Chain.Hit,
+		step.When == ChainOnMiss && b.Chain.Hit:
+		if step.Else == BossStateIdle {
+			return ChainStep{}, false
+		}
+		b.Chain.Final = true
+		return ChainStep{Move: step.Else}, true
+	}
+
+	b.Chain.Step = next
+	return step, true
+}
+
+// advanceChain encadena el siguiente movimiento (llamar desde updateAI con el boss libre).
+// Entre golpes hay un hueco en el que el boss se puede interrumpir.
+func (b *Boss) advanceChain() {
+	if b.Chain.Gap == 0 {
+		b.Chain.Gap = b.config.ChainGap[b.Phase]
+	}
+
+	// Hueco entre golpes: se acerca si el jugador salió de rango
+	b.Chain.Gap--
+	if b.Chain.Gap > 0 {
+		if b.Target != nil && b.Position.Distance(b.Target.Position) > b.config.AttackRange {
+			b.locomotion.approach(b)
+		} else {
+			b.Velocity.X = 0
+		}
+		return
+	}
+
+	step, ok := b.nextChainStep()
+	if !ok {
+		b.EndChain()
+		return
+	}
+
+	// Los movimientos de la cadena ignoran su cooldown
+	b.clearCooldown(step.Move)
+	b.Chain.Hit = false
+	b.Chain.Gap = 0
+	b.shootAim = step.Aim
+
+	b.NextAction = step.Move
+	b.executeAction()
+
+	// No se pudo ejecutar (en el aire, sin objetivo...): se corta la cadena
+	if b.NextAction != BossStateIdle {
+		b.EndChain()
+	}
+}
+
+// clearCooldown deja listo un movimiento
+func (b *Boss) clearCooldown(move BossState) {
+	switch move {
+	case BossStateAttacking:
+		b.AttackCooldown = 0
+	case BossStateSlam:
+		b.SlamCooldown = 0
+	case BossStateCharge:
+		b.ChargeCooldown = 0
+	case BossStateRoar:
+		b.RoarCooldown = 0
+	case BossStateShooting:
+		b.ShootCooldown = 0
+	case BossStateLeap:
+		b.LeapCooldown = 0
+	case BossStateEscape:
+		b.EscapeCooldown = 0
+	case BossStateBackstep:
+		b.BackstepCooldown = 0
+	case BossStateGuard:
+		b.GuardCooldown = 0
+	case BossStateSummon:
+		b.SummonCooldown = 0
+	case BossStateSwoop:
+		b.SwoopCooldown = 0
+	case BossStateVolley:
+		b.VolleyCooldown = 0
+	}
+}
+
+// OnHitLanded avisa al boss de que su golpe conectó (decide las ramas de la cadena)
+func (b *Boss) OnHitLanded() {
+	if b.Chain.IsActive() {
+		b.Chain.Hit = true
+	}
+}
+
+// EndChain termina la cadena en curso (el boss respira un momento antes de volver a decidir)
+func (b *Boss) EndChain() {
+	if !b.Chain.IsActive() {
+		return
+	}
+
+	b.Chain = ChainState{}
+	b.shootAim = 0
+	b.DecisionTimer = b.config.ChainRecovery
+}
+
+// chainWindUpScale acorta los avisos de los golpes que siguen dentro de una cadena
+func (b *Boss) chainWindUpScale() float64 {
+	if b.Chain.IsActive() && (b.Chain.Step > 0 || b.Chain.Final) {
+		return b.config.ChainWindUpScale
+	}
+	return 1.0
+}
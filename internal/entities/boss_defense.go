@@ -0,0 +1,188 @@
+// internal/entities/boss_defense.go
+package entities
+
+import (
+	"image/color"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// ============================================================================
+// DEFENSA: BACKSTEP, GUARDIA Y CONTRAATAQUE
+// ============================================================================
+
+// backstepDirection retorna hacia dónde retrocede el boss (lejos del jugador)
+func (b *Boss) backstepDirection() float64 {
+	if b.Target != nil && b.Target.Position.X > b.Position.X {
+		return -1
+	}
+	return 1
+}
+
+// backstepRoom retorna cuánto espacio tiene el boss detrás
+func (b *Boss) backstepRoom() float64 {
+	bounds := b.arena.GetBounds()
+	if b.backstepDirection() < 0 {
+		return b.Position.X - bounds.X
+	}
+	return bounds.X + bounds.Width - b.Position.X
+}
+
+// performBackstep retrocede con un pequeño salto para sacarse al jugador de encima
+func (b *Boss) performBackstep() {
+	if b.BackstepCooldown > 0 || !b.IsOnGround {
+		return
+	}
+
+	b.State = BossStateBackstep
+	b.BackstepTimeLeft = b.config.BackstepFrames
+	b.BackstepCooldown = b.config.BackstepCooldown
+	b.backstepVelocityX = b.backstepDirection() * b.config.BackstepSpeed * b.Status.SpeedMultiplier()
+	b.Velocity.Y = -b.config.BackstepHop
+	b.IsOnGround = false
+}
+
+// applyBackstep mantiene la velocidad del backstep (después de la fricción)
+func (b *Boss) applyBackstep() {
+	if b.State == BossStateBackstep {
+		b.Velocity.X = b.backstepVelocityX
+	}
+}
+
+// performGuard levanta la guardia mirando al jugador
+func (b *Boss) performGuard() {
+	if b.GuardCooldown > 0 || !b.IsOnGround {
+		return
+	}
+
+	b.updateFacingDirection()
+	b.State = BossStateGuard
+	b.GuardTimeLeft = b.config.GuardDuration
+	b.GuardCooldown = b.config.GuardCooldown
+	b.BlockedHits = 0
+	b.counterReady = false
+	b.Velocity.X = 0
+}
+
+// performCounter responde con un golpe rápido tras bloquear varios ataques
+func (b *Boss) performCounter() {
+	b.counterReady = false
+	b.GuardTimeLeft = 0
+	b.BlockedHits = 0
+
+	b.updateFacingDirection()
+	b.State = BossStateCounter
+	b.Attack.Start(&b.config.CounterAttack)
+}
+
+// updateDefense avanza backstep y guardia (llamar en updateTimers)
+func (b *Boss) updateDefense() {
+	if b.BackstepCooldown > 0 {
+		b.BackstepCooldown--
+	}
+	if b.GuardCooldown > 0 {
+		b.GuardCooldown--
+	}
+
+	// Backstep
+	if b.BackstepTimeLeft > 0 {
+		b.BackstepTimeLeft--
+		if b.State != BossStateBackstep {
+			b.BackstepTimeLeft = 0 // Interrumpido
+		} else if b.BackstepTimeLeft == 0 {
+			b.State = BossStateIdle
+		}
+	}
+
+	// Guardia
+	if b.GuardTimeLeft > 0 {
+		if b.State != BossStateGuard {
+			// Interrumpida (guardia rota, transición de fase...)
+			b.GuardTimeLeft = 0
+			b.BlockedHits = 0
+			b.counterReady = false
+			return
+		}
+
+		if b.counterReady {
+			b.performCounter()
+			return
+		}
+
+		b.GuardTimeLeft--
+		if b.GuardTimeLeft == 0 {
+			b.BlockedHits = 0
+			b.State = BossStateIdle
+		}
+	}
+}
+
+// ResolveGuard bloquea los golpes cuerpo a cuerpo frontales mientras está en guardia.
+// Los disparos cargados rompen la guardia y aturden al boss.
+func (b *Boss) ResolveGuard(hit *combat.Hit, damage int) (combat.GuardResult, int) {
+	if b.State != BossStateGuard {
+		return combat.GuardNone, damage
+	}
+
+	if hit.GuardBreaker {
+		b.Stagger(b.config.GuardBreakStun)
+		return combat.GuardBroken, damage
+	}
+
+	// Solo golpes cuerpo a cuerpo y de frente
+	if hit.Ranged || !b.guardCovers(hit.Source) {
+		return combat.GuardNone, damage
+	}
+
+	b.BlockedHits++
+	if b.BlockedHits >= b.config.CounterThreshold {
+		b.counterReady = true
+	}
+
+	reduced := int(float64(damage) * (1.0 - b.config.GuardDamageReduction))
+	return combat.GuardBlocked, reduced
+}
+
+// guardCovers retorna true si un golpe desde source da contra el escudo:
+// tiene que venir del lado al que mira el boss y no desde arriba (el pogo
+// sobre la cabeza pasa por encima de la guardia)
+func (b *Boss) guardCovers(source utils.Vector2) bool {
+	if source.Y < b.GetHurtbox().Top() {
+		return false
+	}
+	fromRight := source.X >= b.Position.X
+	return fromRight == b.FacingRight
+}
+
+// GetCounterHitbox retorna el hitbox del contraataque
+func (b *Boss) GetCounterHitbox() *utils.Rectangle {
+	if b.State != BossStateCounter || !b.Attack.Is(&b.config.CounterAttack) {
+		return nil
+	}
+
+	return b.Attack.Hitbox(b.Position, b.FacingRight)
+}
+
+// drawGuard dibuja el escudo frontal de la guardia
+func (b *Boss) drawGuard(screen *ebiten.Image) {
+	if b.State != BossStateGuard {
+		return
+	}
+
+	hitbox := b.GetHitbox()
+	x := float32(hitbox.X) - 8
+	if b.FacingRight {
+		x = float32(hitbox.X+hitbox.Width) + 4
+	}
+
+	// El escudo se llena con cada golpe bloqueado (aviso del contraataque)
+	shield := color.RGBA{120, 160, 255, 220}
+	if b.config.CounterThreshold > 0 && b.BlockedHits > 0 {
+		fill := float64(b.BlockedHits) / float64(b.config.CounterThreshold)
+		shield = color.RGBA{uint8(120 + 135*fill), uint8(160 - 100*fill), uint8(255 - 195*fill), 220}
+	}
+	vector.DrawFilledRect(screen, x, float32(hitbox.Y)+10, 4, float32(hitbox.Height)-20, shield, false)
+}
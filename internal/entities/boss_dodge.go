@@ -0,0 +1,100 @@
+// internal/entities/boss_dodge.go
+package entities
+
+import (
+	"github.com/MarcosBrindis/boss-arena-go/internal/ai"
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
+)
+
+// ============================================================================
+// ESQUIVA DE PROYECTILES
+// ============================================================================
+
+// GetDodgeContext retorna lo que la IA de esquiva necesita saber del boss
+func (b *Boss) GetDodgeContext() ai.DodgeContext {
+	bounds := b.arena.GetBounds()
+
+	return ai.DodgeContext{
+		Dodger:       b.ID,
+		Hurtbox:      b.GetHurtbox(),
+		OnGround:     b.IsOnGround,
+		CanAct:       b.canDodge(),
+		MinX:         bounds.X,
+		MaxX:         bounds.X + bounds.Width,
+		JumpVelocity: b.config.JumpForce,
+		Gravity:      b.config.Gravity,
+	}
+}
+
+// canDodge retorna true si el boss está libre para esquivar
+func (b *Boss) canDodge() bool {
+	// La esquiva predice saltos con gravedad: los voladores no la usan
+	if b.config.Flying {
+		return false
+	}
+	if b.State != BossStateIdle && b.State != BossStateWalking {
+		return false
+	}
+	return b.DodgeTimeLeft == 0 && !b.Leap.IsActive() && !b.Status.Has(combat.StatusStun)
+}
+
+// Dodge aplica la reacción elegida por la IA de esquiva
+func (b *Boss) Dodge(decision ai.DodgeDecision) {
+	if !b.canDodge() {
+		return
+	}
+
+	switch decision.Action {
+	case ai.DodgeStep:
+		b.dodgeAction = ai.DodgeStep
+		b.dodgeVelocityX = decision.Direction * decision.StepSpeed * b.Status.SpeedMultiplier()
+		b.DodgeTimeLeft = decision.StepFrames
+
+	case ai.DodgeJump:
+		b.dodgeAction = ai.DodgeJump
+		b.dodgeVelocityX = 0
+		b.DodgeTimeLeft = int(b.leapAirTime(b.config.JumpForce))
+		b.State = BossStateJumping
+		b.Velocity.Y = -b.config.JumpForce
+		b.Velocity.X = 0
+		b.IsOnGround = false
+	}
+}
+
+// updateDodge avanza la esquiva en curso
+func (b *Boss) updateDodge() {
+	if b.DodgeTimeLeft == 0 {
+		return
+	}
+
+	// Interrumpida (stagger, transición de fase, muerte...)
+	switch b.State {
+	case BossStateStunned, BossStateTransition, BossStateDead:
+		b.CancelDodge()
+		return
+	}
+
+	b.DodgeTimeLeft--
+	if b.DodgeTimeLeft == 0 {
+		b.dodgeAction = ai.DodgeNone
+	}
+}
+
+// applyDodgeStep mantiene la velocidad del paso lateral (después de la fricción)
+func (b *Boss) applyDodgeStep() {
+	if b.DodgeTimeLeft > 0 && b.dodgeAction == ai.DodgeStep {
+		b.Velocity.X = b.dodgeVelocityX
+	}
+}
+
+// IsDodging retorna true si el boss está esquivando
+func (b *Boss) IsDodging() bool {
+	return b.DodgeTimeLeft > 0
+}
+
+// CancelDodge descarta la esquiva en curso
+func (b *Boss) CancelDodge() {
+	b.DodgeTimeLeft = 0
+	b.dodgeAction = ai.DodgeNone
+	b.dodgeVelocityX = 0
+}
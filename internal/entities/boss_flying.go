@@ -0,0 +1,220 @@
+// internal/entities/boss_flying.go
+package entities
+
+import (
+	"github.com/MarcosBrindis/boss-arena-go/internal/ai"
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+)
+
+// ============================================================================
+// MOVIMIENTOS DE VUELO: PICADA Y RÁFAGA
+// ============================================================================
+
+// performSwoop cae en diagonal hacia el jugador
+func (b *Boss) performSwoop() {
+	if b.SwoopCooldown > 0 || b.Target == nil || b.IsOnGround {
+		return
+	}
+
+	// Siempre hacia abajo, aunque el jugador esté saltando
+	direction := b.Target.Position.Sub(b.Position).Normalize()
+	direction.Y = utils.Max(direction.Y, 0.4)
+	direction = direction.Normalize()
+
+	speed := b.config.SwoopSpeed * b.phaseSpeedMultiplier() * b.Status.SpeedMultiplier()
+
+	b.State = BossStateSwoop
+	b.Attack.Start(&b.config.SwoopAttack)
+	b.SwoopCooldown = b.config.SwoopCooldown
+	b.swoopVelocity = direction.Mul(speed)
+	b.swoopStartY = b.Position.Y
+	b.swoopBottomY = utils.Max(b.Target.Position.Y, b.Position.Y+60)
+	b.FacingRight = direction.X > 0
+}
+
+// updateSwoop gira en el fondo de la V y termina al volver a su altura (llamar en updateTimers)
+func (b *Boss) updateSwoop() {
+	if b.SwoopCooldown > 0 {
+		b.SwoopCooldown--
+	}
+	if b.State != BossStateSwoop {
+		return
+	}
+
+	// Bajando: a la altura del jugador (o al tocar el suelo) sube por el otro lado
+	if b.swoopVelocity.Y > 0 {
+		if b.IsOnGround || b.Position.Y >= b.swoopBottomY {
+			b.swoopVelocity.Y = -b.swoopVelocity.Y
+		}
+		return
+	}
+
+	if b.Position.Y <= b.swoopStartY {
+		b.endAttack()
+	}
+}
+
+// endSwoop termina la picada conservando algo de inercia
+func (b *Boss) endSwoop() {
+	b.Velocity = b.swoopVelocity.Mul(0.3)
+	b.swoopVelocity = utils.Zero()
+	b.State = BossStateIdle
+}
+
+// GetSwoopHitbox retorna el hitbox de la picada
+func (b *Boss) GetSwoopHitbox() *utils.Rectangle {
+	if b.State != BossStateSwoop || !b.Attack.Is(&b.config.SwoopAttack) {
+		return nil
+	}
+
+	return b.Attack.Hitbox(b.Position, b.FacingRight)
+}
+
+// GetSwoopDirection retorna hacia dónde va la picada (knockback)
+func (b *Boss) GetSwoopDirection() utils.Vector2 {
+	return b.swoopVelocity.Normalize()
+}
+
+// performVolley se queda quieto en el aire y dispara una ráfaga en abanico
+func (b *Boss) performVolley() {
+	if b.VolleyCooldown > 0 || b.Target == nil {
+		return
+	}
+
+	b.State = BossStateVolley
+	b.VolleyCooldown = b.config.VolleyCooldown
+	b.volleyShotsLeft = b.config.VolleyShots[b.Phase]
+	b.volleyTimer = 0
+	b.Velocity = utils.Zero()
+}
+
+// updateVolley dispara un proyectil cada VolleyInterval frames (llamar en updateTimers)
+func (b *Boss) updateVolley() {
+	if b.VolleyCooldown > 0 {
+		b.VolleyCooldown--
+	}
+	if b.volleyShotsLeft <= 0 && b.State != BossStateVolley {
+		return
+	}
+
+	// Interrumpida (flinch, postura rota, transición de fase...)
+	if b.State != BossStateVolley {
+		b.volleyShotsLeft = 0
+		b.shootAim = 0
+		return
+	}
+
+	if b.volleyTimer > 0 {
+		b.volleyTimer--
+		return
+	}
+	if b.volleyShotsLeft == 0 {
+		b.shootAim = 0
+		b.State = BossStateIdle
+		return
+	}
+
+	// El abanico barre de un lado al otro del jugador
+	total := b.config.VolleyShots[b.Phase]
+	b.shootAim = 0
+	if total > 1 {
+		shot := total - b.volleyShotsLeft
+		b.shootAim = -b.config.VolleySpread/2 + b.config.VolleySpread*float64(shot)/float64(total-1)
+	}
+
+	b.ProjectileType = 0 // Fireball
+	b.ShootDelay = 0
+	b.WantsToShoot = true
+	b.volleyShotsLeft--
+	b.volleyTimer = b.config.VolleyInterval
+}
+
+// ============================================================================
+// IA DE UTILIDAD (VUELO)
+// ============================================================================
+
+// flyingBossMoves retorna los movimientos de la Arpía: nunca camina ni salta,
+// se recoloca en el aire, cae en picada y dispara desde arriba
+func flyingBossMoves(b *Boss) []ai.UtilityMove {
+	return []ai.UtilityMove{
+		{
+			Name: "swoop",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return b.SwoopCooldown == 0 && !b.IsOnGround && ctx.HorizontalDistance < 500
+			},
+			Considerations: []ai.Consideration{
+				// En el suelo no puede saltar por encima de la picada
+				{Name: "en suelo", Weight: 2, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Bool(!ctx.TargetAirborne)
+				}},
+				{Name: "media distancia", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Band(ctx.HorizontalDistance, 100, 300, 150)
+				}},
+				{Name: "acorralado", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ctx.TargetCornered
+				}},
+			},
+			Counters: []ai.HabitCounter{
+				{Habit: ai.HabitWallCamping, Strength: 0.6},
+				// Si el jugador ya atraviesa la picada, usarla menos
+				{Habit: ai.HabitDashThrough, Strength: -0.8},
+			},
+			PhaseWeights: []float64{1.0, 1.1, 1.3},
+		},
+		{
+			Name: "volley",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return b.VolleyCooldown == 0
+			},
+			Considerations: []ai.Consideration{
+				{Name: "lejos", Weight: 1.5, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Linear(ctx.HorizontalDistance, 100, 400)
+				}},
+				// En el aire no puede esquivar con dash tan fácil
+				{Name: "en aire", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Bool(ctx.TargetAirborne)
+				}},
+			},
+			Counters: []ai.HabitCounter{
+				{Habit: ai.HabitRangedShots, Strength: 0.8},
+				// El que rebota encima se come la ráfaga
+				{Habit: ai.HabitPogo, Strength: 0.6},
+			},
+			PhaseWeights: []float64{0.8, 1.0, 1.2},
+		},
+		{
+			Name: "shoot",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return b.ShootCooldown == 0 && ctx.HorizontalDistance > 150
+			},
+			Considerations: []ai.Consideration{
+				{Name: "lejos", Weight: 2, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Linear(ctx.HorizontalDistance, 150, 450)
+				}},
+			},
+			PhaseWeights: []float64{0.8, 0.9, 0.7},
+		},
+		{
+			Name: "walk",
+			Available: func(ctx *ai.UtilityContext) bool {
+				return b.Position.Distance(b.hoverPoint()) > 40
+			},
+			Considerations: []ai.Consideration{
+				{Name: "fuera de posición", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return ai.Linear(b.Position.Distance(b.hoverPoint()), 40, 300)
+				}},
+			},
+			PhaseWeights: []float64{0.6, 0.6, 0.6},
+			Repeatable:   true,
+		},
+		{
+			Name: "idle",
+			Considerations: []ai.Consideration{
+				{Name: "base", Weight: 1, Score: func(ctx *ai.UtilityContext) float64 {
+					return 0.1
+				}},
+			},
+			Repeatable: true,
+		},
+	}
+}
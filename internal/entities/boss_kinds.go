@@ -0,0 +1,207 @@
+// internal/entities/boss_kinds.go
+package entities
+
+import (
+	"image/color"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/audio"
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+	"github.com/MarcosBrindis/boss-arena-go/internal/world"
+)
+
+// ============================================================================
+// TIPOS DE BOSS
+// ============================================================================
+
+// BossKind es el tipo de boss (comparten toda la infraestructura, cambia la configuración)
+type BossKind int
+
+const (
+	BossTitan BossKind = iota // Terrestre: camina, salta, golpea el suelo
+	BossHarpy                 // Voladora: planea, cae en picada y dispara desde arriba
+)
+
+// String retorna el nombre del tipo (menú)
+func (k BossKind) String() string {
+	switch k {
+	case BossTitan:
+		return "Titán"
+	case BossHarpy:
+		return "Arpía"
+	default:
+		return "Unknown"
+	}
+}
+
+// BossConfigFor retorna la configuración de un tipo de boss
+func BossConfigFor(kind BossKind) BossConfig {
+	switch kind {
+	case BossHarpy:
+		return FlyingBossConfig()
+	default:
+		return DefaultBossConfig()
+	}
+}
+
+// NewBossOfKind crea un boss del tipo indicado
+func NewBossOfKind(kind BossKind, x, y float64, arena *world.Arena) *Boss {
+	return NewBossWithConfig(BossConfigFor(kind), x, y, arena)
+}
+
+// FlyingBossConfig retorna la configuración de la Arpía.
+// Parte del Titán y reemplaza lo que depende del suelo.
+func FlyingBossConfig() BossConfig {
+	cfg := DefaultBossConfig()
+
+	// Identidad: más pequeña y con menos vida (cuesta más alcanzarla)
+	cfg.Kind = BossHarpy
+	cfg.Name = "🦅 ARPÍA"
+	cfg.MaxHealth = 800
+	cfg.Size = utils.NewVector2(90, 70)
+	cfg.PhaseColors = map[BossPhase]color.RGBA{
+		Phase1: {90, 200, 220, 255},
+		Phase2: {70, 140, 230, 255},
+		Phase3: {170, 90, 240, 255},
+	}
+
+	// Planea a la altura de un doble salto
+	cfg.Flying = true
+	cfg.WalkSpeed = 3.5
+	cfg.AttackRange = 320.0 // No se acerca más que su punto de planeo
+	cfg.HoverHeight = 260.0
+	cfg.MinHoverHeight = 170.0
+	cfg.HoverOffsetX = 140.0
+	cfg.TakeoffSpeed = 3.0
+	cfg.Friction = 0.9
+
+	// Picada en V: baja en diagonal hasta el jugador y vuelve a subir
+	cfg.SwoopAttack = combat.AttackDefinition{
+		Name:     "harpy_swoop",
+		Startup:  0,  // El aviso es la anticipación
+		Active:   80, // Tope: termina antes al volver a su altura
+		Recovery: 0,
+		Hitboxes: []combat.HitboxShape{
+			{OffsetX: -45, OffsetY: -35, Width: 90, Height: 70},
+		},
+		Damage:     22,
+		DamageType: combat.DamagePhysical,
+		Knockback:  9,
+		Parryable:  true,
+	}
+	cfg.SwoopSpeed = 9.0
+	cfg.SwoopCooldown = 150
+
+	// Ráfaga en abanico que barre de un lado al otro
+	cfg.VolleyShots = map[BossPhase]int{
+		Phase1: 3,
+		Phase2: 4,
+		Phase3: 5,
+	}
+	cfg.VolleyInterval = 10
+	cfg.VolleySpread = 0.6
+	cfg.VolleyCooldown = 200
+
+	// Sin invocaciones
+	cfg.SummonWaves = nil
+
+	// Tres pogos seguidos: se sacude al jugador con una picada
+	cfg.PogoCounter = BossStateSwoop
+
+	// La picada no se interrumpe; la ráfaga sí (los disparos cargados la tumban)
+	cfg.MoveArmor = map[BossState]combat.ArmorLevel{
+		BossStateSwoop: combat.ArmorHyper,
+	}
+
+	// Postura baja: derribarla es la forma de castigarla en el suelo
+	cfg.PhasePoise = map[BossPhase]float64{
+		Phase1: 70,
+		Phase2: 80,
+		Phase3: 60,
+	}
+	cfg.PoiseBreakStun = 150
+
+	// Resiste la magia, es débil a los golpes (si la alcanzas)
+	cfg.PhaseDefenses = map[BossPhase]combat.Defenses{
+		Phase1: {
+			Defense: 2,
+			Resistances: map[combat.DamageType]float64{
+				combat.DamagePhysical: -0.2,
+				combat.DamageMagic:    0.3,
+			},
+		},
+		Phase2: {
+			Defense: 3,
+			Resistances: map[combat.DamageType]float64{
+				combat.DamageMagic: 0.4,
+			},
+		},
+		Phase3: {
+			Defense: 1,
+			Resistances: map[combat.DamageType]float64{
+				combat.DamagePhysical: -0.4,
+				combat.DamageMagic:    0.2,
+			},
+		},
+	}
+
+	cfg.Telegraphs = map[BossState]Telegraph{
+		BossStateSwoop: {
+			WindUp: 26,
+			Cues:   CueGlow | CueDirectionLine,
+			Color:  color.RGBA{255, 60, 160, 255},
+			Sound:  audio.SoundTelegraph,
+		},
+		BossStateVolley: {
+			WindUp: 22,
+			Cues:   CueFlash | CueGlow,
+			Color:  color.RGBA{255, 200, 120, 255},
+			Sound:  audio.SoundBossRoar,
+		},
+		BossStateShooting: {
+			WindUp: 18,
+			Cues:   CueFlash | CueDirectionLine,
+			Color:  color.RGBA{255, 69, 0, 255},
+			Sound:  audio.SoundTelegraph,
+		},
+	}
+
+	cfg.Chains = map[BossPhase][]AttackChain{
+		Phase1: {
+			{Name: "picada doble", Steps: []ChainStep{
+				{Move: BossStateSwoop},
+				// Si falla vuelve a caer; si acierta, remata desde arriba
+				{Move: BossStateSwoop, When: ChainOnMiss, Else: BossStateVolley},
+			}},
+		},
+		Phase2: {
+			{Name: "picada y ráfaga", Steps: []ChainStep{
+				{Move: BossStateSwoop},
+				{Move: BossStateVolley},
+			}},
+			{Name: "ráfaga y picada", Steps: []ChainStep{
+				{Move: BossStateVolley},
+				{Move: BossStateSwoop},
+			}},
+		},
+		Phase3: {
+			{Name: "tormenta", Steps: []ChainStep{
+				{Move: BossStateVolley},
+				{Move: BossStateSwoop},
+				{Move: BossStateSwoop, When: ChainOnMiss, Else: BossStateVolley},
+			}},
+			{Name: "picada triple", Steps: []ChainStep{
+				{Move: BossStateSwoop},
+				{Move: BossStateSwoop},
+				{Move: BossStateSwoop, When: ChainOnMiss, Else: BossStateVolley},
+			}},
+		},
+	}
+	cfg.ChainChance = map[BossPhase]float64{
+		Phase1: 0.2,
+		Phase2: 0.45,
+		Phase3: 0.8,
+	}
+
+	return cfg
+}
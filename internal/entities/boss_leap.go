@@ -0,0 +1,181 @@
+// internal/entities/boss_leap.go
+package entities
+
+import (
+	"image/color"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// ============================================================================
+// SALTOS (LEAP Y ESCAPE)
+// ============================================================================
+
+// LeapKind es el tipo de salto en curso
+type LeapKind int
+
+const (
+	LeapNone   LeapKind = iota
+	LeapAttack          // Cae sobre el jugador con un impacto
+	LeapEscape          // Salta por encima del jugador para salir de una esquina
+)
+
+// LeapState es el salto en curso
+type LeapState struct {
+	Kind      LeapKind
+	TargetX   float64 // Dónde va a caer
+	ChainSlam bool    // Al aterrizar encadena un slam (fases avanzadas)
+}
+
+// IsActive retorna true si el boss está en medio de un salto
+func (ls *LeapState) IsActive() bool {
+	return ls.Kind != LeapNone
+}
+
+// leapAirTime retorna los frames que tarda en volver al suelo con una velocidad inicial
+func (b *Boss) leapAirTime(jumpVelocity float64) float64 {
+	if b.config.Gravity <= 0 {
+		return 1
+	}
+	return 2 * jumpVelocity / b.config.Gravity
+}
+
+// clampToArena limita una X al rango en el que cabe el boss
+func (b *Boss) clampToArena(x float64) float64 {
+	bounds := b.arena.GetBounds()
+	return utils.Clamp(x, bounds.X, bounds.X+bounds.Width)
+}
+
+// predictLandingX estima dónde estará el jugador cuando el boss caiga
+func (b *Boss) predictLandingX() float64 {
+	if b.Target == nil {
+		return b.Position.X
+	}
+
+	// Anticipa el movimiento horizontal, con un tope para no pasarse de listo
+	airTime := b.leapAirTime(b.config.JumpForce)
+	lead := utils.Clamp(b.Target.Velocity.X*airTime, -b.config.LeapMaxLead, b.config.LeapMaxLead)
+	return b.clampToArena(b.Target.Position.X + lead)
+}
+
+// escapeLandingX retorna el lado del jugador opuesto a la pared más cercana
+func (b *Boss) escapeLandingX() float64 {
+	if b.Target == nil {
+		return b.Position.X
+	}
+
+	bounds := b.arena.GetBounds()
+	direction := 1.0
+	if b.Position.X > bounds.X+bounds.Width/2 {
+		direction = -1.0
+	}
+	return b.clampToArena(b.Target.Position.X + direction*(b.Size.X+b.config.EscapeClearance))
+}
+
+// performLeap salta hacia donde va a estar el jugador
+func (b *Boss) performLeap() {
+	if b.LeapCooldown > 0 || b.Target == nil || !b.IsOnGround {
+		return
+	}
+
+	chance := b.config.LeapChainSlamChance[b.Phase]
+	b.startJump(LeapAttack, b.predictLandingX(), b.config.JumpForce)
+	b.Leap.ChainSlam = chance > 0 && b.rng.Float64() < chance
+	b.LeapCooldown = b.config.LeapCooldown
+}
+
+// performEscapeJump salta por encima del jugador cuando el boss está acorralado
+func (b *Boss) performEscapeJump() {
+	if b.EscapeCooldown > 0 || b.Target == nil || !b.IsOnGround {
+		return
+	}
+
+	b.startJump(LeapEscape, b.escapeLandingX(), b.config.JumpForce*b.config.EscapeJumpScale)
+	b.EscapeCooldown = b.config.EscapeCooldown
+}
+
+// startJump lanza al boss en parábola hacia targetX
+func (b *Boss) startJump(kind LeapKind, targetX, jumpVelocity float64) {
+	// La física limita la velocidad vertical a MaxFallSpeed
+	jumpVelocity = utils.Min(jumpVelocity, b.config.MaxFallSpeed)
+
+	speed := (targetX - b.Position.X) / b.leapAirTime(jumpVelocity)
+	speed = utils.Clamp(speed, -b.config.LeapMaxSpeed, b.config.LeapMaxSpeed)
+
+	b.Leap = LeapState{Kind: kind, TargetX: targetX}
+	b.State = BossStateJumping
+	if kind == LeapEscape {
+		// Estado propio: no se confunde con un salto normal (memoria de la IA, debug)
+		b.State = BossStateEscape
+	}
+	b.Velocity = utils.NewVector2(speed, -jumpVelocity)
+	b.IsOnGround = false
+	b.FacingRight = targetX > b.Position.X
+}
+
+// updateLeap detecta el aterrizaje (llamar después de mover al boss)
+func (b *Boss) updateLeap() {
+	if !b.Leap.IsActive() {
+		return
+	}
+
+	// Interrumpido en el aire (parry, transición de fase, muerte...)
+	if b.State != BossStateJumping && b.State != BossStateFalling && b.State != BossStateEscape {
+		b.Leap = LeapState{}
+		return
+	}
+
+	if b.Velocity.Y < 0 || !b.arena.IsOnGround(b.GetHitbox()) {
+		return
+	}
+
+	leap := b.Leap
+	b.Leap = LeapState{}
+	b.Velocity.X = 0
+
+	if leap.Kind != LeapAttack {
+		b.State = BossStateIdle
+		return
+	}
+
+	// Impacto al caer
+	b.State = BossStateLeap
+	b.Attack.Start(&b.config.LeapAttack)
+	b.chainSlam = leap.ChainSlam
+	b.WantsLeapImpact = true
+}
+
+// endLeapImpact termina el impacto y, si corresponde, encadena el slam
+func (b *Boss) endLeapImpact() {
+	chain := b.chainSlam
+	b.chainSlam = false
+	b.State = BossStateIdle
+
+	if chain {
+		b.performSlam()
+	}
+}
+
+// GetLeapHitbox retorna el hitbox del impacto del leap
+func (b *Boss) GetLeapHitbox() *utils.Rectangle {
+	if b.State != BossStateLeap || !b.Attack.Is(&b.config.LeapAttack) {
+		return nil
+	}
+
+	return b.Attack.Hitbox(b.Position, b.FacingRight)
+}
+
+// drawLeapMarker marca en el suelo dónde va a caer el boss
+func (b *Boss) drawLeapMarker(screen *ebiten.Image) {
+	if b.Leap.Kind != LeapAttack || len(b.config.LeapAttack.Hitboxes) == 0 {
+		return
+	}
+
+	area := b.config.LeapAttack.Hitboxes[0].Resolve(utils.NewVector2(b.Leap.TargetX, b.arena.GetFloorY()-b.Size.Y/2), b.FacingRight)
+	groundY := float32(b.arena.GetFloorY())
+
+	vector.DrawFilledRect(screen, float32(area.X), groundY-6, float32(area.Width), 6, color.RGBA{180, 80, 255, 160}, false)
+	vector.StrokeLine(screen, float32(b.Leap.TargetX), groundY-30, float32(b.Leap.TargetX), groundY, 2, color.RGBA{180, 80, 255, 220}, false)
+}
@@ -0,0 +1,133 @@
+// internal/entities/boss_locomotion.go
+package entities
+
+import "github.com/MarcosBrindis/boss-arena-go/internal/utils"
+
+// ============================================================================
+// LOCOMOCIÓN (SUELO O VUELO)
+// ============================================================================
+
+// bossLocomotion es cómo se mueve un tipo de boss. El resto del boss
+// (avisos, cadenas, postura, armadura) no sabe si camina o vuela.
+type bossLocomotion interface {
+	applyPhysics(b *Boss)           // Gravedad, fricción o sustentación
+	approach(b *Boss)               // Acercarse al jugador (acción "walk")
+	restingState(b *Boss) BossState // Estado cuando no está haciendo nada especial
+}
+
+// newBossLocomotion elige la locomoción según la configuración
+func newBossLocomotion(cfg *BossConfig) bossLocomotion {
+	if cfg.Flying {
+		return flyingLocomotion{}
+	}
+	return groundLocomotion{}
+}
+
+// ============================================================================
+// SUELO (TITÁN)
+// ============================================================================
+
+// groundLocomotion camina por el suelo y salta con gravedad
+type groundLocomotion struct{}
+
+func (groundLocomotion) applyPhysics(b *Boss) {
+	b.applyGroundPhysics()
+}
+
+func (groundLocomotion) approach(b *Boss) {
+	b.walkTowardsPlayer()
+}
+
+func (groundLocomotion) restingState(b *Boss) BossState {
+	if !b.IsOnGround {
+		if b.Velocity.Y < 0 {
+			return BossStateJumping
+		}
+		return BossStateFalling
+	}
+	if utils.Abs(b.Velocity.X) > 0.5 {
+		return BossStateWalking
+	}
+	return BossStateIdle
+}
+
+// ============================================================================
+// VUELO (ARPÍA)
+// ============================================================================
+
+// flyingLocomotion planea sin gravedad; solo cae al suelo cuando la aturden
+type flyingLocomotion struct{}
+
+func (flyingLocomotion) applyPhysics(b *Boss) {
+	switch b.State {
+	case BossStateSwoop:
+		b.Velocity = b.swoopVelocity
+		return
+	case BossStateStunned:
+		// Aturdida cae al suelo: es la ventana para castigarla
+		b.applyGroundPhysics()
+		return
+	}
+
+	// Sin gravedad: frena en los dos ejes
+	b.Velocity = b.Velocity.Mul(b.config.Friction)
+	if b.Velocity.Length() < 0.1 {
+		b.Velocity = utils.Zero()
+	}
+
+	// Nunca baja de la altura mínima (al recuperarse del stun despega)
+	lowestY := b.arena.GetFloorY() - b.config.MinHoverHeight
+	if b.Position.Y > lowestY {
+		b.Velocity.Y = utils.Min(b.Velocity.Y, -b.config.TakeoffSpeed)
+	}
+}
+
+func (flyingLocomotion) approach(b *Boss) {
+	if b.Target == nil {
+		return
+	}
+
+	toPoint := b.hoverPoint().Sub(b.Position)
+	distance := toPoint.Length()
+	if distance < 1 {
+		return
+	}
+
+	// Frena al llegar para no oscilar alrededor del punto
+	speed := b.config.WalkSpeed * b.phaseSpeedMultiplier() * b.Status.SpeedMultiplier()
+	speed = utils.Min(speed, distance/8)
+	b.Velocity = toPoint.Normalize().Mul(speed)
+}
+
+func (flyingLocomotion) restingState(b *Boss) BossState {
+	if b.Velocity.Length() > 0.5 {
+		return BossStateWalking
+	}
+	return BossStateIdle
+}
+
+// hoverPoint retorna dónde planea el boss: por encima del jugador y a un lado
+func (b *Boss) hoverPoint() utils.Vector2 {
+	if b.Target == nil {
+		return b.Position
+	}
+
+	side := 1.0
+	if b.Position.X < b.Target.Position.X {
+		side = -1.0
+	}
+	x := b.clampToArena(b.Target.Position.X + side*b.config.HoverOffsetX)
+	return utils.NewVector2(x, b.arena.GetFloorY()-b.config.HoverHeight)
+}
+
+// phaseSpeedMultiplier retorna cuánto acelera el boss en cada fase
+func (b *Boss) phaseSpeedMultiplier() float64 {
+	switch b.Phase {
+	case Phase2:
+		return 1.3
+	case Phase3:
+		return 1.6
+	default:
+		return 1.0
+	}
+}
@@ -0,0 +1,97 @@
+// internal/entities/boss_poise.go
+package entities
+
+import (
+	"image/color"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// ============================================================================
+// POSTURA (POISE) Y STAGGER
+// ============================================================================
+
+// ResetPoise llena la postura al máximo de la fase actual
+func (b *Boss) ResetPoise() {
+	b.MaxPoise = b.config.PhasePoise[b.Phase]
+	b.Poise = b.MaxPoise
+	b.poiseRegenDelay = 0
+	b.poiseBroken = false
+}
+
+// ApplyPoiseDamage desgasta la postura. Al romperse, el boss queda aturdido
+// y recibe daño extra hasta que se recupera (implementa combat.Staggerable).
+func (b *Boss) ApplyPoiseDamage(amount float64) bool {
+	if b.poiseBroken || b.MaxPoise <= 0 || amount <= 0 ||
+		b.State == BossStateTransition || b.State == BossStateDead {
+		return false
+	}
+
+	b.Poise -= amount
+	b.poiseRegenDelay = b.config.PoiseRegenDelay
+
+	// La súper armadura aguanta la postura al mínimo hasta terminar el movimiento
+	if b.currentArmor() == combat.ArmorSuper && b.Poise < 1 {
+		b.Poise = 1
+	}
+	if b.Poise > 0 {
+		return false
+	}
+
+	b.Poise = 0
+	b.poiseBroken = true
+	b.Stagger(b.config.PoiseBreakStun)
+	return true
+}
+
+// IsPoiseBroken retorna true durante la ventana de castigo
+func (b *Boss) IsPoiseBroken() bool {
+	return b.poiseBroken
+}
+
+// PoisePercent retorna la postura restante (0-1)
+func (b *Boss) PoisePercent() float64 {
+	if b.MaxPoise <= 0 {
+		return 0
+	}
+	return utils.Clamp(b.Poise/b.MaxPoise, 0, 1)
+}
+
+// updatePoise regenera la postura (llamar en updateTimers)
+func (b *Boss) updatePoise() {
+	if b.poiseBroken {
+		// Se recupera al terminar el aturdimiento
+		if b.State != BossStateStunned {
+			b.ResetPoise()
+		}
+		return
+	}
+
+	if b.poiseRegenDelay > 0 {
+		b.poiseRegenDelay--
+		return
+	}
+	b.Poise = utils.Min(b.Poise+b.config.PoiseRegen, b.MaxPoise)
+}
+
+// drawPoiseBar dibuja la barra de postura bajo la barra de vida
+func (b *Boss) drawPoiseBar(screen *ebiten.Image, x, y, width float32) {
+	height := float32(3)
+	vector.DrawFilledRect(screen, x, y, width, height, color.RGBA{50, 50, 50, 255}, false)
+
+	fill := color.RGBA{230, 200, 80, 255}
+	if b.poiseBroken {
+		// Parpadea mientras dura la ventana de castigo
+		fill = color.RGBA{255, 80, 80, 255}
+		if (b.StunTimeLeft/6)%2 == 0 {
+			fill = color.RGBA{255, 255, 255, 255}
+		}
+		vector.DrawFilledRect(screen, x, y, width, height, fill, false)
+		return
+	}
+
+	vector.DrawFilledRect(screen, x, y, width*float32(b.PoisePercent()), height, fill, false)
+}
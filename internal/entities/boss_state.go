@@ -15,6 +15,15 @@ const (
 	BossStateShooting   // Disparo de proyectil
 	BossStateStunned    // Aturdido (vulnerable)
 	BossStateTransition // Transición de fase
+	BossStateWindUp     // Aviso previo a un movimiento (telegraph)
+	BossStateLeap       // Impacto al caer de un salto
+	BossStateBackstep   // Retrocede con un salto corto
+	BossStateGuard      // Guardia: bloquea golpes frontales
+	BossStateCounter    // Contraataque tras bloquear
+	BossStateSummon     // Invoca minions
+	BossStateSwoop      // Picada en V (vuelo)
+	BossStateVolley     // Ráfaga de disparos desde arriba (vuelo)
+	BossStateEscape     // Salto por encima del jugador para salir de una esquina
 	BossStateDead
 )
 
@@ -43,6 +52,24 @@ func (s BossState) String() string {
 		return "Stunned"
 	case BossStateTransition:
 		return "Transition"
+	case BossStateWindUp:
+		return "WindUp"
+	case BossStateLeap:
+		return "Leap"
+	case BossStateBackstep:
+		return "Backstep"
+	case BossStateGuard:
+		return "Guard"
+	case BossStateCounter:
+		return "Counter"
+	case BossStateSummon:
+		return "Summon"
+	case BossStateSwoop:
+		return "Swoop"
+	case BossStateVolley:
+		return "Volley"
+	case BossStateEscape:
+		return "Escape"
 	case BossStateDead:
 		return "Dead"
 	default:
@@ -0,0 +1,54 @@
+// internal/entities/boss_summon.go
+package entities
+
+// ============================================================================
+// INVOCACIÓN DE MINIONS
+// ============================================================================
+
+// performSummon levanta los brazos para invocar una oleada de minions.
+// Los minions aparecen al terminar: si lo interrumpen, no hay invocación.
+func (b *Boss) performSummon() {
+	if b.SummonCooldown > 0 || b.MinionCount >= b.config.MaxMinions {
+		return
+	}
+
+	b.State = BossStateSummon
+	b.SummonTimeLeft = b.config.SummonDuration
+	b.SummonCooldown = b.config.SummonCooldown
+	b.Velocity.X = 0
+}
+
+// updateSummon avanza la invocación (llamar en updateTimers)
+func (b *Boss) updateSummon() {
+	if b.SummonCooldown > 0 {
+		b.SummonCooldown--
+	}
+	if b.SummonTimeLeft <= 0 {
+		return
+	}
+
+	// Interrumpida (flinch, parry, transición de fase...)
+	if b.State != BossStateSummon {
+		b.SummonTimeLeft = 0
+		return
+	}
+
+	b.SummonTimeLeft--
+	if b.SummonTimeLeft == 0 {
+		b.WantsSummon = true
+		b.State = BossStateIdle
+	}
+}
+
+// GetSummonWave retorna los minions a invocar según la fase (sin pasarse del máximo)
+func (b *Boss) GetSummonWave() []MinionKind {
+	wave := b.config.SummonWaves[b.Phase]
+	room := b.config.MaxMinions - b.MinionCount
+	if room <= 0 {
+		return nil
+	}
+	if len(wave) > room {
+		wave = wave[:room]
+	}
+	return wave
+}
@@ -0,0 +1,322 @@
+// internal/entities/boss_telegraph.go
+package entities
+
+import (
+	"image/color"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/audio"
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// ============================================================================
+// TELEGRAPHS (AVISOS DE ATAQUE)
+// ============================================================================
+
+// TelegraphCue son las señales visuales de un aviso (se combinan con |)
+type TelegraphCue uint8
+
+const (
+	CueFlash         TelegraphCue = 1 << iota // El cuerpo parpadea en blanco al final
+	CueGlow                                   // Aura de color que crece alrededor del boss
+	CueGroundMarker                           // Marca en el suelo con el alcance del golpe
+	CueDirectionLine                          // Línea con la dirección del ataque
+)
+
+// Telegraph describe el aviso previo a un movimiento del boss
+type Telegraph struct {
+	WindUp int // Frames base (se escalan con dificultad y fase)
+	Cues   TelegraphCue
+	Color  color.RGBA
+	Sound  audio.SoundType // Se reproduce al empezar el aviso
+}
+
+// TelegraphState es el aviso en curso
+type TelegraphState struct {
+	Move     BossState // Movimiento que se ejecuta al terminar
+	Def      *Telegraph
+	Frame    int
+	Duration int
+}
+
+// IsActive retorna true si hay un aviso en curso
+func (ts *TelegraphState) IsActive() bool {
+	return ts.Def != nil
+}
+
+// Progress retorna el avance del aviso (0..1)
+func (ts *TelegraphState) Progress() float64 {
+	if ts.Duration <= 0 {
+		return 1
+	}
+	return float64(ts.Frame) / float64(ts.Duration)
+}
+
+// minWindUpFrames es el aviso más corto posible (aun en difícil y fase 3)
+const minWindUpFrames = 6
+
+// SetDifficulty ajusta la duración de los avisos (1 = fácil, 2 = normal, 3 = difícil)
+func (b *Boss) SetDifficulty(level int) {
+	b.difficulty = level
+}
+
+// windUpFrames retorna la duración del aviso según dificultad y fase
+func (b *Boss) windUpFrames(def *Telegraph) int {
+	scale := b.chainWindUpScale()
+
+	if index := b.difficulty - 1; index >= 0 && index < len(b.config.DifficultyWindUpScale) {
+		scale *= b.config.DifficultyWindUpScale[index]
+	}
+	if phaseScale, ok := b.config.PhaseWindUpScale[b.Phase]; ok {
+		scale *= phaseScale
+	}
+
+	frames := int(float64(def.WindUp)*scale + 0.5)
+	if frames < minWindUpFrames {
+		frames = minWindUpFrames
+	}
+	return frames
+}
+
+// moveReady retorna true si el movimiento no está en cooldown
+func (b *Boss) moveReady(move BossState) bool {
+	switch move {
+	case BossStateAttacking:
+		return b.AttackCooldown == 0
+	case BossStateSlam:
+		return b.SlamCooldown == 0 && b.IsOnGround
+	case BossStateCharge:
+		return b.ChargeCooldown == 0 && b.Target != nil
+	case BossStateRoar:
+		return b.RoarCooldown == 0
+	case BossStateShooting:
+		return b.ShootCooldown == 0 && b.Target != nil
+	case BossStateLeap:
+		return b.LeapCooldown == 0 && b.Target != nil && b.IsOnGround
+	case BossStateEscape:
+		return b.EscapeCooldown == 0 && b.Target != nil && b.IsOnGround
+	case BossStateBackstep:
+		return b.BackstepCooldown == 0 && b.IsOnGround
+	case BossStateGuard:
+		return b.GuardCooldown == 0 && b.IsOnGround
+	case BossStateSummon:
+		return b.SummonCooldown == 0 && b.MinionCount < b.config.MaxMinions
+	case BossStateSwoop:
+		return b.SwoopCooldown == 0 && b.Target != nil && !b.IsOnGround
+	case BossStateVolley:
+		return b.VolleyCooldown == 0 && b.Target != nil
+	default:
+		return false
+	}
+}
+
+// startTelegraph inicia el aviso de un movimiento (o lo ejecuta si no tiene aviso)
+func (b *Boss) startTelegraph(move BossState) {
+	if !b.moveReady(move) {
+		return
+	}
+	b.NextAction = BossStateIdle
+
+	def, ok := b.config.Telegraphs[move]
+	if !ok {
+		b.performMove(move)
+		return
+	}
+
+	b.Telegraph = TelegraphState{
+		Move:     move,
+		Def:      &def,
+		Duration: b.windUpFrames(&def),
+	}
+	b.State = BossStateWindUp
+	b.Velocity.X = 0
+	b.WantsTelegraphSound = true
+}
+
+// updateTelegraph avanza el aviso y ejecuta el movimiento al terminar
+func (b *Boss) updateTelegraph() {
+	if !b.Telegraph.IsActive() {
+		return
+	}
+
+	// Interrumpido (parry, transición de fase, stagger...)
+	if b.State != BossStateWindUp {
+		b.Telegraph = TelegraphState{}
+		return
+	}
+
+	b.Telegraph.Frame++
+	if b.Telegraph.Frame < b.Telegraph.Duration {
+		return
+	}
+
+	move := b.Telegraph.Move
+	b.Telegraph = TelegraphState{}
+	b.State = BossStateIdle
+	b.performMove(move)
+}
+
+// CancelTelegraph descarta el aviso en curso
+func (b *Boss) CancelTelegraph() {
+	b.Telegraph = TelegraphState{}
+	b.WantsTelegraphSound = false
+}
+
+// GetTelegraphSound retorna el sonido del aviso que acaba de empezar
+func (b *Boss) GetTelegraphSound() audio.SoundType {
+	if b.Telegraph.Def == nil {
+		return audio.SoundTelegraph
+	}
+	return b.Telegraph.Def.Sound
+}
+
+// performMove ejecuta un movimiento ya avisado
+func (b *Boss) performMove(move BossState) {
+	switch move {
+	case BossStateAttacking:
+		b.performBasicAttack()
+	case BossStateSlam:
+		b.performSlam()
+	case BossStateCharge:
+		b.performCharge()
+	case BossStateRoar:
+		b.performRoar()
+	case BossStateShooting:
+		b.performShoot()
+	case BossStateLeap:
+		b.performLeap()
+	case BossStateEscape:
+		b.performEscapeJump()
+	case BossStateBackstep:
+		b.performBackstep()
+	case BossStateGuard:
+		b.performGuard()
+	case BossStateSummon:
+		b.performSummon()
+	case BossStateSwoop:
+		b.performSwoop()
+	case BossStateVolley:
+		b.performVolley()
+	}
+}
+
+// ============================================================================
+// DIBUJO DE AVISOS
+// ============================================================================
+
+// drawTelegraph dibuja las señales del aviso en curso
+func (b *Boss) drawTelegraph(screen *ebiten.Image) {
+	if !b.Telegraph.IsActive() {
+		return
+	}
+
+	def := b.Telegraph.Def
+	progress := float32(b.Telegraph.Progress())
+	hitbox := b.GetHitbox()
+
+	// Aura que crece con el aviso
+	if def.Cues&CueGlow != 0 {
+		grow := 4 + 14*progress
+		glow := def.Color
+		glow.A = uint8(60 + 140*progress)
+		vector.StrokeRect(
+			screen,
+			float32(hitbox.X)-grow,
+			float32(hitbox.Y)-grow,
+			float32(hitbox.Width)+grow*2,
+			float32(hitbox.Height)+grow*2,
+			3,
+			glow,
+			false,
+		)
+	}
+
+	// Marca en el suelo con el alcance del golpe
+	if def.Cues&CueGroundMarker != 0 {
+		if rect := b.telegraphGroundArea(); rect != nil {
+			groundY := float32(b.GetGroundY())
+			marker := def.Color
+			marker.A = uint8(50 + 110*progress)
+			vector.DrawFilledRect(screen, float32(rect.X), groundY-6, float32(rect.Width), 6, marker, false)
+
+			// Bordes del golpe
+			edge := def.Color
+			edge.A = uint8(200 * progress)
+			vector.StrokeLine(screen, float32(rect.X), groundY-20, float32(rect.X), groundY, 2, edge, false)
+			vector.StrokeLine(screen, float32(rect.X+rect.Width), groundY-20, float32(rect.X+rect.Width), groundY, 2, edge, false)
+		}
+
+		// Slam: hasta dónde llegan las ondas de choque (más tenue que el golpe)
+		if b.Telegraph.Move == BossStateSlam {
+			groundY := float32(b.GetGroundY())
+			left, right := b.shockwaveReach()
+			wave := def.Color
+			wave.A = uint8(30 + 70*progress)
+			vector.DrawFilledRect(screen, float32(left), groundY-2, float32(right-left), 2, wave, false)
+			vector.StrokeLine(screen, float32(left), groundY-12, float32(left), groundY, 2, wave, false)
+			vector.StrokeLine(screen, float32(right), groundY-12, float32(right), groundY, 2, wave, false)
+		}
+	}
+
+	// Línea de dirección (hacia dónde va a cargar o disparar)
+	if def.Cues&CueDirectionLine != 0 && b.Target != nil {
+		direction := b.Target.Position.Sub(b.Position).Normalize()
+		end := b.Position.Add(direction.Mul(b.telegraphLineLength()))
+		line := def.Color
+		line.A = uint8(80 + 150*progress)
+		vector.StrokeLine(screen, float32(b.Position.X), float32(b.Position.Y), float32(end.X), float32(end.Y), 2+3*progress, line, false)
+	}
+}
+
+// telegraphFlashing retorna true si el cuerpo debe parpadear en blanco este frame
+func (b *Boss) telegraphFlashing() bool {
+	if !b.Telegraph.IsActive() || b.Telegraph.Def.Cues&CueFlash == 0 {
+		return false
+	}
+
+	// Parpadeo en el último tercio del aviso, cada vez más rápido
+	remaining := b.Telegraph.Duration - b.Telegraph.Frame
+	if remaining*3 > b.Telegraph.Duration {
+		return false
+	}
+	return (remaining/3)%2 == 0
+}
+
+// telegraphGroundArea retorna la zona del suelo que cubre el golpe avisado
+// (el alcance de las ondas del slam se dibuja aparte, con shockwaveReach)
+func (b *Boss) telegraphGroundArea() *utils.Rectangle {
+	def := &b.config.BasicAttack
+	origin := b.Position
+	switch b.Telegraph.Move {
+	case BossStateSlam:
+		def = &b.config.SlamAttack
+	case BossStateLeap:
+		// Sigue al jugador hasta que el boss salta
+		def = &b.config.LeapAttack
+		origin.X = b.predictLandingX()
+	case BossStateRoar:
+		area := utils.NewRectangle(b.Position.X-b.config.RoarRange, b.GetGroundY()-1, b.config.RoarRange*2, 1)
+		return &area
+	}
+
+	if len(def.Hitboxes) == 0 {
+		return nil
+	}
+	area := def.Hitboxes[0].Resolve(origin, b.FacingRight)
+	return &area
+}
+
+// telegraphLineLength retorna el largo de la línea de dirección
+func (b *Boss) telegraphLineLength() float64 {
+	switch b.Telegraph.Move {
+	case BossStateCharge:
+		return utils.Min(b.config.ChargeSpeed*float64(b.config.ChargeAttack.Active)*0.5, 500)
+	case BossStateSwoop:
+		// Hasta el jugador: el fondo de la V
+		if b.Target != nil {
+			return b.Position.Distance(b.Target.Position)
+		}
+	}
+	return 250
+}
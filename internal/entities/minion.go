@@ -0,0 +1,452 @@
+// internal/entities/minion.go
+package entities
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+	"github.com/MarcosBrindis/boss-arena-go/internal/world"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// ============================================================================
+// MINIONS
+// ============================================================================
+
+// MinionKind es el tipo de minion
+type MinionKind int
+
+const (
+	MinionCrawler MinionKind = iota // Camina por el suelo hacia el jugador
+	MinionDrone                     // Vuela sobre el jugador y se lanza en picado
+)
+
+// String retorna el nombre del tipo de minion
+func (k MinionKind) String() string {
+	switch k {
+	case MinionCrawler:
+		return "crawler"
+	case MinionDrone:
+		return "drone"
+	default:
+		return "unknown"
+	}
+}
+
+// MinionState representa el estado de un minion
+type MinionState int
+
+const (
+	MinionStateSpawning   MinionState = iota // Apareciendo (invulnerable, sin daño)
+	MinionStateMoving                        // Persiguiendo al jugador
+	MinionStateWindUp                        // Preparando el picado (drones)
+	MinionStateDiving                        // En picado (drones)
+	MinionStateRecovering                    // Volviendo a su altura (drones)
+	MinionStateHurt                          // Retrocediendo tras un golpe
+	MinionStateDead
+)
+
+// MinionConfig contiene la configuración de un tipo de minion
+type MinionConfig struct {
+	Health        int
+	Size          utils.Vector2
+	Speed         float64
+	ContactDamage int
+	Knockback     float64
+	Color         color.RGBA
+
+	SpawnFrames int // Frames apareciendo
+	HurtFrames  int // Frames aturdido tras un golpe
+
+	// Crawler
+	LungeRange float64 // Acelera cuando el jugador está cerca
+	LungeScale float64
+
+	// Drone
+	HoverHeight  float64 // Altura sobre el jugador
+	DiveCooldown int
+	DiveWindUp   int
+	DiveSpeed    float64
+	DiveFrames   int
+
+	// Física
+	Gravity      float64
+	MaxFallSpeed float64
+
+	// Recompensa
+	OrbStamina float64
+}
+
+// DefaultMinionConfig retorna la configuración por defecto de cada tipo
+func DefaultMinionConfig(kind MinionKind) MinionConfig {
+	switch kind {
+	case MinionDrone:
+		return MinionConfig{
+			Health:        20,
+			Size:          utils.NewVector2(30, 24),
+			Speed:         2.5,
+			ContactDamage: 10,
+			Knockback:     6,
+			Color:         color.RGBA{120, 220, 255, 255},
+			SpawnFrames:   30,
+			HurtFrames:    15,
+			HoverHeight:   180,
+			DiveCooldown:  150,
+			DiveWindUp:    30, // Parpadea antes de lanzarse
+			DiveSpeed:     9,
+			DiveFrames:    40,
+			OrbStamina:    20,
+		}
+	default:
+		return MinionConfig{
+			Health:        30,
+			Size:          utils.NewVector2(36, 28),
+			Speed:         1.8,
+			ContactDamage: 8,
+			Knockback:     5,
+			Color:         color.RGBA{150, 90, 60, 255},
+			SpawnFrames:   30,
+			HurtFrames:    15,
+			LungeRange:    140,
+			LungeScale:    1.8,
+			Gravity:       0.6,
+			MaxFallSpeed:  12,
+			OrbStamina:    25,
+		}
+	}
+}
+
+// Minion es un enemigo pequeño invocado por el boss
+type Minion struct {
+	ID       combat.ActorID
+	Kind     MinionKind
+	State    MinionState
+	Position utils.Vector2
+	Velocity utils.Vector2
+	Size     utils.Vector2
+
+	Health    int
+	MaxHealth int
+
+	FacingRight bool
+	IsOnGround  bool
+
+	StateTimer int // Frames restantes del estado actual
+	DiveTimer  int // Frames hasta el próximo picado
+	diveDir    utils.Vector2
+	age        int
+	hurtFlash  int
+
+	LastAttacker combat.ActorID // Último que le conectó un golpe (a quién se atribuye la muerte)
+
+	Target *Player
+	arena  *world.Arena
+	config MinionConfig
+}
+
+// NewMinion crea un minion (index numera los minions de la pelea)
+func NewMinion(kind MinionKind, index int, x, y float64, arena *world.Arena) *Minion {
+	cfg := DefaultMinionConfig(kind)
+
+	return &Minion{
+		ID:         combat.NewActorID(combat.ActorKindMinion, index, combat.FactionEnemy),
+		Kind:       kind,
+		State:      MinionStateSpawning,
+		Position:   utils.NewVector2(x, y),
+		Size:       cfg.Size,
+		Health:     cfg.Health,
+		MaxHealth:  cfg.Health,
+		StateTimer: cfg.SpawnFrames,
+		// Escalonar los picados para que no bajen todos a la vez
+		DiveTimer: cfg.DiveCooldown + (index%3)*30,
+		arena:     arena,
+		config:    cfg,
+	}
+}
+
+// SetTarget establece el objetivo del minion
+func (m *Minion) SetTarget(player *Player) {
+	m.Target = player
+}
+
+// IsAlive retorna true si el minion sigue en pelea
+func (m *Minion) IsAlive() bool {
+	return m.State != MinionStateDead
+}
+
+// Update actualiza el minion
+func (m *Minion) Update() {
+	if m.State == MinionStateDead {
+		return
+	}
+
+	m.age++
+	if m.hurtFlash > 0 {
+		m.hurtFlash--
+	}
+	if m.StateTimer > 0 {
+		m.StateTimer--
+	}
+
+	switch m.State {
+	case MinionStateSpawning:
+		m.Velocity.X = 0
+		if m.StateTimer == 0 {
+			m.State = MinionStateMoving
+		}
+	case MinionStateHurt:
+		m.Velocity.X *= 0.85
+		if m.Kind == MinionDrone {
+			m.Velocity.Y *= 0.85 // Los drones no tienen gravedad
+		}
+		if m.StateTimer == 0 {
+			m.State = MinionStateMoving
+		}
+	default:
+		if m.Kind == MinionDrone {
+			m.updateDrone()
+		} else {
+			m.updateCrawler()
+		}
+	}
+
+	m.applyMovement()
+}
+
+// updateCrawler persigue al jugador por el suelo y acelera al acercarse
+func (m *Minion) updateCrawler() {
+	if m.Target == nil || !m.IsOnGround {
+		return
+	}
+
+	dx := m.Target.Position.X - m.Position.X
+	m.FacingRight = dx > 0
+
+	speed := m.config.Speed
+	if utils.Abs(dx) < m.config.LungeRange {
+		speed *= m.config.LungeScale
+	}
+
+	direction := 1.0
+	if dx < 0 {
+		direction = -1.0
+	}
+	m.Velocity.X = direction * speed
+}
+
+// updateDrone flota sobre el jugador y se lanza en picado cada cierto tiempo
+func (m *Minion) updateDrone() {
+	if m.Target == nil {
+		m.Velocity = utils.Zero()
+		return
+	}
+
+	switch m.State {
+	case MinionStateMoving:
+		// Flotar sobre el jugador con un leve vaivén
+		hover := utils.NewVector2(
+			m.Target.Position.X,
+			m.Target.Position.Y-m.config.HoverHeight+math.Sin(float64(m.age)*0.08)*12,
+		)
+		toHover := hover.Sub(m.Position)
+		if toHover.Length() > m.config.Speed {
+			m.Velocity = toHover.Normalize().Mul(m.config.Speed)
+		} else {
+			m.Velocity = toHover
+		}
+		m.FacingRight = m.Target.Position.X > m.Position.X
+
+		if m.DiveTimer > 0 {
+			m.DiveTimer--
+		} else {
+			m.State = MinionStateWindUp
+			m.StateTimer = m.config.DiveWindUp
+		}
+
+	case MinionStateWindUp:
+		m.Velocity = utils.Zero()
+		if m.StateTimer == 0 {
+			m.diveDir = m.Target.Position.Sub(m.Position).Normalize()
+			m.State = MinionStateDiving
+			m.StateTimer = m.config.DiveFrames
+		}
+
+	case MinionStateDiving:
+		m.Velocity = m.diveDir.Mul(m.config.DiveSpeed)
+		if m.StateTimer == 0 || m.IsOnGround {
+			m.State = MinionStateRecovering
+			m.StateTimer = m.config.DiveFrames
+		}
+
+	case MinionStateRecovering:
+		m.Velocity = utils.NewVector2(0, -m.config.Speed)
+		if m.StateTimer == 0 || m.Position.Y <= m.Target.Position.Y-m.config.HoverHeight {
+			m.State = MinionStateMoving
+			m.DiveTimer = m.config.DiveCooldown
+		}
+	}
+}
+
+// applyMovement mueve al minion resolviendo colisiones con la arena
+func (m *Minion) applyMovement() {
+	if m.Kind == MinionCrawler {
+		m.Velocity.Y = utils.Min(m.Velocity.Y+m.config.Gravity, m.config.MaxFallSpeed)
+	}
+
+	newX := m.Position.X + m.Velocity.X
+	if collides, _ := m.arena.CheckCollision(m.rectAt(newX, m.Position.Y)); !collides {
+		m.Position.X = newX
+	} else {
+		m.Velocity.X = 0
+	}
+
+	newY := m.Position.Y + m.Velocity.Y
+	if collides, _ := m.arena.CheckCollision(m.rectAt(m.Position.X, newY)); !collides {
+		m.Position.Y = newY
+	} else {
+		m.Velocity.Y = 0
+	}
+
+	m.IsOnGround = m.arena.IsOnGround(m.GetHitbox())
+}
+
+// rectAt retorna el rectángulo del minion centrado en (x, y)
+func (m *Minion) rectAt(x, y float64) utils.Rectangle {
+	return utils.NewRectangle(x-m.Size.X/2, y-m.Size.Y/2, m.Size.X, m.Size.Y)
+}
+
+// GetHitbox retorna el rectángulo de colisión
+func (m *Minion) GetHitbox() utils.Rectangle {
+	return m.rectAt(m.Position.X, m.Position.Y)
+}
+
+// GetHurtbox retorna el rectángulo de daño (todo el cuerpo: son pequeños)
+func (m *Minion) GetHurtbox() utils.Rectangle {
+	return m.GetHitbox()
+}
+
+// CanDealContact retorna true si tocar al minion hace daño
+func (m *Minion) CanDealContact() bool {
+	return m.State != MinionStateSpawning && m.State != MinionStateDead
+}
+
+// GetContactDamage retorna el daño por contacto
+func (m *Minion) GetContactDamage() int {
+	return m.config.ContactDamage
+}
+
+// GetContactKnockback retorna el knockback base del contacto
+func (m *Minion) GetContactKnockback() float64 {
+	return m.config.Knockback
+}
+
+// GetOrbStamina retorna la stamina del orbe que suelta al morir
+func (m *Minion) GetOrbStamina() float64 {
+	return m.config.OrbStamina
+}
+
+// ============================================================================
+// COMBATE (implementa combat.Damageable)
+// ============================================================================
+
+// GetActorID retorna el ID de actor del minion
+func (m *Minion) GetActorID() combat.ActorID {
+	return m.ID
+}
+
+// GetPosition retorna la posición del minion
+func (m *Minion) GetPosition() utils.Vector2 {
+	return m.Position
+}
+
+// CanReceiveHit es false mientras aparece o si está muerto
+func (m *Minion) CanReceiveHit() bool {
+	return m.State != MinionStateSpawning && m.State != MinionStateDead
+}
+
+// ReceiveDamage aplica el daño; los minions salen despedidos con cada golpe
+func (m *Minion) ReceiveDamage(damage int, knockback utils.Vector2) bool {
+	if !m.CanReceiveHit() {
+		return false
+	}
+
+	m.Health -= damage
+	m.hurtFlash = 6
+	if m.Health <= 0 {
+		m.Health = 0
+		m.Die()
+		return true
+	}
+
+	// Un golpe corta el picado de los drones
+	m.State = MinionStateHurt
+	m.StateTimer = m.config.HurtFrames
+	m.Velocity.X = knockback.X * 1.5
+	if m.Kind == MinionCrawler {
+		m.Velocity.Y = -3
+	} else {
+		m.Velocity.Y = knockback.Y
+		m.DiveTimer = m.config.DiveCooldown
+	}
+	return true
+}
+
+// Die mata al minion
+func (m *Minion) Die() {
+	m.State = MinionStateDead
+	m.Velocity = utils.Zero()
+}
+
+// ============================================================================
+// DIBUJO
+// ============================================================================
+
+// Draw dibuja el minion
+func (m *Minion) Draw(screen *ebiten.Image) {
+	if m.State == MinionStateDead {
+		return
+	}
+
+	hitbox := m.GetHitbox()
+	bodyColor := m.config.Color
+
+	switch {
+	case m.State == MinionStateSpawning:
+		// Parpadea mientras aparece
+		if (m.StateTimer/4)%2 == 0 {
+			bodyColor.A = 90
+		}
+	case m.hurtFlash > 0:
+		bodyColor = color.RGBA{255, 255, 255, 255}
+	case m.State == MinionStateWindUp && (m.StateTimer/4)%2 == 0:
+		bodyColor = color.RGBA{255, 80, 80, 255}
+	}
+
+	x, y := float32(hitbox.X), float32(hitbox.Y)
+	w, h := float32(hitbox.Width), float32(hitbox.Height)
+	vector.DrawFilledRect(screen, x, y, w, h, bodyColor, false)
+	vector.StrokeRect(screen, x, y, w, h, 2, color.RGBA{40, 20, 20, 255}, false)
+
+	// Ojo (indica hacia dónde mira)
+	eyeX := x + w*0.3
+	if m.FacingRight {
+		eyeX = x + w*0.7
+	}
+	vector.DrawFilledCircle(screen, eyeX, y+h*0.35, 3, color.RGBA{255, 230, 0, 255}, false)
+
+	// Hélices del dron
+	if m.Kind == MinionDrone {
+		spin := float32(math.Sin(float64(m.age) * 0.6))
+		vector.StrokeLine(screen, x+w/2-12*spin, y-4, x+w/2+12*spin, y-4, 2, color.RGBA{200, 200, 200, 255}, false)
+	}
+
+	// Barra de vida (solo si está herido)
+	if m.Health < m.MaxHealth {
+		fill := float32(m.Health) / float32(m.MaxHealth)
+		vector.DrawFilledRect(screen, x, y-8, w, 3, color.RGBA{50, 50, 50, 255}, false)
+		vector.DrawFilledRect(screen, x, y-8, w*fill, 3, color.RGBA{255, 80, 80, 255}, false)
+	}
+}
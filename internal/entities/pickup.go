@@ -0,0 +1,88 @@
+// internal/entities/pickup.go
+package entities
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+	"github.com/MarcosBrindis/boss-arena-go/internal/world"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// ============================================================================
+// ORBES DE STAMINA
+// ============================================================================
+
+const (
+	orbSize     = 12.0
+	orbLifetime = 600 // 10 segundos antes de desaparecer
+	orbGravity  = 0.4
+)
+
+// StaminaOrb es un orbe que suelta un minion al morir y recarga stamina
+type StaminaOrb struct {
+	Position  utils.Vector2
+	Velocity  utils.Vector2
+	Amount    float64
+	Age       int
+	Collected bool
+
+	arena *world.Arena
+}
+
+// NewStaminaOrb crea un orbe que salta un poco al aparecer
+func NewStaminaOrb(position utils.Vector2, amount float64, arena *world.Arena) *StaminaOrb {
+	return &StaminaOrb{
+		Position: position,
+		Velocity: utils.NewVector2(0, -4),
+		Amount:   amount,
+		arena:    arena,
+	}
+}
+
+// IsActive retorna true mientras se puede recoger
+func (o *StaminaOrb) IsActive() bool {
+	return !o.Collected && o.Age < orbLifetime
+}
+
+// Update cae hasta el suelo
+func (o *StaminaOrb) Update() {
+	o.Age++
+
+	o.Velocity.Y += orbGravity
+	newY := o.Position.Y + o.Velocity.Y
+	rect := utils.NewRectangle(o.Position.X-orbSize/2, newY-orbSize/2, orbSize, orbSize)
+	if collides, _ := o.arena.CheckCollision(rect); collides {
+		o.Velocity.Y = 0
+		return
+	}
+	o.Position.Y = newY
+}
+
+// GetHitbox retorna el área de recogida
+func (o *StaminaOrb) GetHitbox() utils.Rectangle {
+	return utils.NewRectangle(o.Position.X-orbSize/2, o.Position.Y-orbSize/2, orbSize, orbSize)
+}
+
+// Collect recarga la stamina del jugador
+func (o *StaminaOrb) Collect(player *Player) {
+	o.Collected = true
+	player.Stamina = utils.Min(player.Stamina+o.Amount, player.MaxStamina)
+}
+
+// Draw dibuja el orbe (parpadea cuando está por desaparecer)
+func (o *StaminaOrb) Draw(screen *ebiten.Image) {
+	if !o.IsActive() {
+		return
+	}
+	if orbLifetime-o.Age < 120 && (o.Age/6)%2 == 0 {
+		return
+	}
+
+	pulse := float32(math.Sin(float64(o.Age)*0.15)) * 2
+	x, y := float32(o.Position.X), float32(o.Position.Y)
+	vector.DrawFilledCircle(screen, x, y, orbSize/2+4+pulse, color.RGBA{80, 255, 120, 60}, false)
+	vector.DrawFilledCircle(screen, x, y, orbSize/2, color.RGBA{80, 255, 120, 255}, false)
+}
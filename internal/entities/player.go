@@ -3,6 +3,7 @@ package entities
 import (
 	"image/color"
 
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
 	"github.com/MarcosBrindis/boss-arena-go/internal/config"
 	"github.com/MarcosBrindis/boss-arena-go/internal/input"
 	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
@@ -13,6 +14,9 @@ import (
 
 // Player representa al jugador
 type Player struct {
+	// Identidad
+	ID combat.ActorID
+
 	// Posición y física
 	Position utils.Vector2
 	Velocity utils.Vector2
@@ -38,9 +42,17 @@ type Player struct {
 	DashDirection utils.Vector2
 
 	// Combate
-	AttackTimeLeft int
+	Attack         combat.AttackState // Ataque en curso (frame data)
 	ComboCount     int
 	ComboTimeLeft  int
+	InvulnTimeLeft int // Invulnerabilidad tras recibir un golpe
+
+	// Guardia / Parry
+	GuardFrames int // Frames desde que se levantó la guardia
+
+	// Esquiva perfecta
+	DamageBuffTimeLeft int  // Frames restantes del buff de daño
+	perfectDodgeUsed   bool // Solo una esquiva perfecta por dash
 
 	// Stats
 	Health     int
@@ -48,6 +60,9 @@ type Player struct {
 	Stamina    float64
 	MaxStamina float64
 
+	// Efectos de estado (stun, slow, burn...)
+	Status *combat.StatusEffects
+
 	// Referencias
 	controller *input.Controller
 	arena      *world.Arena
@@ -81,6 +96,7 @@ type PlayerConfig struct {
 	DoubleJumpForce  float64
 	WallJumpForceX   float64
 	WallJumpForceY   float64
+	PogoBounceForce  float64 // Rebote al conectar un ataque hacia abajo
 	CoyoteTimeFrames int
 	JumpBufferFrames int
 
@@ -89,14 +105,38 @@ type PlayerConfig struct {
 	WallStickFrames int
 
 	// Dash
-	DashSpeed    float64
-	DashDuration int // Frames
-	DashCooldown int // Frames
+	DashSpeed       float64
+	DashDuration    int // Frames
+	DashCooldown    int // Frames
+	DashIFrameStart int // Primer frame invencible del dash (0 = primer frame)
+	DashIFrameEnd   int // Último frame invencible del dash
+
+	// Esquiva perfecta (dash a través de un hitbox activo)
+	PerfectDodgeSlowFrames   int     // Duración de la cámara lenta (frames reales)
+	PerfectDodgeSlowScale    float64 // Velocidad del juego durante la cámara lenta
+	PerfectDodgeBuffDuration int     // Duración del buff de daño
+	PerfectDodgeDamageBonus  float64 // Daño extra del buff (0.5 = +50%)
 
 	// Combate
-	AttackDuration int
-	ComboDuration  int
-	MaxCombo       int
+	BasicAttack         combat.AttackDefinition
+	DownAirAttack       combat.AttackDefinition
+	ComboDuration       int
+	MaxCombo            int
+	PostHitInvulnFrames int // Invulnerabilidad tras recibir daño
+
+	// Defensas
+	Defense     int                           // Daño restado a cada golpe
+	Resistances map[combat.DamageType]float64 // Fracción resistida por tipo
+
+	// Stun
+	StunMashReduction int // Frames que se restan por cada botón presionado
+
+	// Guardia / Parry
+	GuardDamageReduction float64 // Porcentaje de daño bloqueado (0-1)
+	GuardStaminaCost     float64 // Stamina base por golpe bloqueado
+	GuardBreakStun       int     // Stun al romperse la guardia por falta de stamina
+	ParryWindowFrames    int     // Frames tras levantar la guardia en los que un golpe es parry
+	ParryStaminaRefund   float64 // Stamina recuperada al hacer parry
 
 	// Física
 	Gravity        float64
@@ -120,6 +160,7 @@ func DefaultPlayerConfig() PlayerConfig {
 		DoubleJumpForce:  11.0,
 		WallJumpForceX:   10.0,
 		WallJumpForceY:   12.0,
+		PogoBounceForce:  13.0,
 		CoyoteTimeFrames: 6,
 		JumpBufferFrames: 5,
 
@@ -128,14 +169,72 @@ func DefaultPlayerConfig() PlayerConfig {
 		WallStickFrames: 10,
 
 		// Dash
-		DashSpeed:    15.0,
-		DashDuration: 10, // ~166ms a 60fps
-		DashCooldown: 30, // ~500ms a 60fps
+		DashSpeed:       15.0,
+		DashDuration:    10, // ~166ms a 60fps
+		DashCooldown:    30, // ~500ms a 60fps
+		DashIFrameStart: 1,  // El primer frame es vulnerable
+		DashIFrameEnd:   7,  // Los últimos frames del dash son vulnerables
+
+		// Esquiva perfecta
+		PerfectDodgeSlowFrames:   40,
+		PerfectDodgeSlowScale:    0.4,
+		PerfectDodgeBuffDuration: 180, // 3 segundos
+		PerfectDodgeDamageBonus:  0.5,
 
 		// Combate
-		AttackDuration: 15,
-		ComboDuration:  30,
-		MaxCombo:       3,
+		BasicAttack: combat.AttackDefinition{
+			Name:     "player_attack",
+			Startup:  3,
+			Active:   6,
+			Recovery: 6,
+			// Corte que se extiende: alcance corto los primeros frames
+			// (el jugador mide 40x60, el hitbox empieza en su borde)
+			Hitboxes: []combat.HitboxShape{
+				{OffsetX: 20, OffsetY: -30, Width: 60, Height: 60},
+				{OffsetX: 20, OffsetY: -30, Width: 60, Height: 60},
+				{OffsetX: 20, OffsetY: -30, Width: 90, Height: 60},
+			},
+			Damage:     10, // Por golpe del combo
+			DamageType: combat.DamagePhysical,
+			Knockback:  2,
+			CritChance: 0.15,
+			// La recuperación se cancela con el siguiente golpe del combo
+			CancelWindows: []combat.CancelWindow{{Start: 9, End: 14}},
+		},
+		DownAirAttack: combat.AttackDefinition{
+			Name:     "player_down_air",
+			Startup:  0,
+			Active:   16,
+			Recovery: 4,
+			Hitboxes: []combat.HitboxShape{
+				{OffsetX: -25, OffsetY: 20, Width: 50, Height: 40}, // Justo debajo
+			},
+			Damage:      20, // Más daño que ataque normal
+			DamageType:  combat.DamagePhysical,
+			Knockback:   2,
+			CritChance:  0.25,
+			PoiseDamage: 2.0, // El pogo desgasta mucho la postura del boss
+			Interrupts:  true,
+		},
+		ComboDuration:       30,
+		MaxCombo:            3,
+		PostHitInvulnFrames: 30, // 0.5 segundos
+
+		// Defensas
+		Defense: 2,
+		Resistances: map[combat.DamageType]float64{
+			combat.DamageFire: -0.1, // Algo débil al fuego
+		},
+
+		// Stun
+		StunMashReduction: 6, // ~10 pulsaciones para salir de un stun de 1 segundo
+
+		// Guardia / Parry
+		GuardDamageReduction: 0.7, // Bloquea el 70% del daño
+		GuardStaminaCost:     10.0,
+		GuardBreakStun:       45,
+		ParryWindowFrames:    6, // 100ms @ 60 FPS
+		ParryStaminaRefund:   25.0,
 
 		// Física
 		Gravity:        0.6,
@@ -150,6 +249,7 @@ func NewPlayer(x, y float64, controller *input.Controller, arena *world.Arena) *
 	cfg := DefaultPlayerConfig()
 
 	return &Player{
+		ID:       combat.PlayerActor,
 		Position: utils.NewVector2(x, y),
 		Velocity: utils.Zero(),
 		Size:     utils.NewVector2(40, 60), // 40x60 pixels
@@ -165,6 +265,8 @@ func NewPlayer(x, y float64, controller *input.Controller, arena *world.Arena) *
 
 		CanDash: true,
 
+		Status: combat.NewStatusEffects(),
+
 		controller: controller,
 		arena:      arena,
 		config:     cfg,
@@ -184,6 +286,12 @@ func (p *Player) Update() {
 	// 1. Actualizar temporizadores
 	p.updateTimers()
 
+	// Efectos de estado (burn, regen, duración del stun...)
+	p.updateStatusEffects()
+	if p.State == StateDead {
+		return
+	}
+
 	// 2. Verificar colisiones ANTES de todo (IMPORTANTE)
 	p.updateCollisionState()
 
@@ -191,10 +299,15 @@ func (p *Player) Update() {
 	p.updateState()
 
 	// 4. Procesar input
-	p.handleInput()
+	if p.State == StateStunned {
+		// Aturdido: solo puede machacar botones para recuperarse antes
+		p.handleStunMash()
+	} else {
+		p.handleInput()
 
-	// Manejar input de disparo (NUEVO)
-	p.handleShootInput()
+		// Manejar input de disparo (NUEVO)
+		p.handleShootInput()
+	}
 
 	// 5. Aplicar física
 	p.applyPhysics()
@@ -229,19 +342,29 @@ func (p *Player) updateTimers() {
 		}
 	}
 
-	// Ataque
-	if p.AttackTimeLeft > 0 {
-		p.AttackTimeLeft--
-		if p.AttackTimeLeft == 0 {
-			p.State = StateIdle
-		}
-	}
+	// Ataque (el estado se resuelve en updateState)
+	p.Attack.Advance()
 
 	// Cooldown de disparo
 	if p.shootCooldown > 0 {
 		p.shootCooldown--
 	}
 
+	// Buff de esquiva perfecta
+	if p.DamageBuffTimeLeft > 0 {
+		p.DamageBuffTimeLeft--
+	}
+
+	// Invulnerabilidad tras recibir un golpe
+	if p.InvulnTimeLeft > 0 {
+		p.InvulnTimeLeft--
+	}
+
+	// Guardia
+	if p.State == StateGuarding {
+		p.GuardFrames++
+	}
+
 	// Combo
 	if p.ComboTimeLeft > 0 {
 		p.ComboTimeLeft--
@@ -280,10 +403,67 @@ func (p *Player) updateCollisionState() {
 	p.IsTouchingWall, p.WallSide = p.arena.IsTouchingWall(hitbox)
 }
 
+// updateStatusEffects avanza los efectos de estado y aplica sus ticks
+func (p *Player) updateStatusEffects() {
+	damage, heal := p.Status.Update()
+
+	if heal > 0 {
+		p.Health += heal
+		if p.Health > p.MaxHealth {
+			p.Health = p.MaxHealth
+		}
+	}
+
+	// El daño por tiempo ignora dash y knockback
+	if damage > 0 {
+		p.Health -= damage
+		if p.Health <= 0 {
+			p.Health = 0
+			p.Die()
+		}
+	}
+}
+
+// handleStunMash reduce el stun cada vez que se presiona un botón
+func (p *Player) handleStunMash() {
+	if p.controller.IsAttackPressed() || p.controller.IsJumpPressed() || p.controller.IsDashPressed() {
+		p.Status.Reduce(combat.StatusStun, p.config.StunMashReduction)
+		p.controller.Vibrate(30, 0.2)
+	}
+}
+
+// ApplyStun aturde al jugador durante los frames indicados
+func (p *Player) ApplyStun(duration int) bool {
+	if p.State == StateDead {
+		return false
+	}
+
+	if !p.Status.ApplyWithDuration(combat.StatusStun, duration) {
+		return false
+	}
+
+	// Cancelar acciones en curso
+	p.Attack.Stop()
+	p.DashTimeLeft = 0
+	p.isChargingShot = false
+	p.chargeTime = 0
+	p.Velocity.X = 0
+	p.State = StateStunned
+
+	p.controller.Vibrate(300, 0.8)
+	return true
+}
+
 // updateState actualiza el estado del jugador
 func (p *Player) updateState() {
+	// Stun tiene prioridad sobre todo lo demás
+	if p.Status.Has(combat.StatusStun) {
+		p.State = StateStunned
+		return
+	}
+
 	// Down Air Attack termina por tiempo
-	if p.State == StateDownAirAttack && p.AttackTimeLeft <= 0 {
+	if p.State == StateDownAirAttack && !p.Attack.IsRunning() {
 		p.State = StateFalling
 		return
 	}
@@ -294,14 +474,14 @@ func (p *Player) updateState() {
 		return
 	}
 
-	if p.State == StateAttacking && p.AttackTimeLeft <= 0 {
+	if p.State == StateAttacking && !p.Attack.IsRunning() {
 		// Ataque terminado
 		p.State = StateIdle
 		return
 	}
 
 	// Estados que no se pueden interrumpir mientras están activos
-	if p.State == StateDashing || p.State == StateAttacking || p.State == StateHurt {
+	if p.State == StateDashing || p.State == StateAttacking || p.State == StateGuarding || p.State == StateHurt {
 		return
 	}
 
@@ -372,9 +552,103 @@ func (p *Player) regenerateStamina() {
 	}
 }
 
+// IsInvincible retorna true durante los i-frames del dash
+func (p *Player) IsInvincible() bool {
+	if p.State != StateDashing {
+		return false
+	}
+
+	elapsed := p.config.DashDuration - p.DashTimeLeft
+	return elapsed >= p.config.DashIFrameStart && elapsed <= p.config.DashIFrameEnd
+}
+
+// TryPerfectDodge registra una esquiva perfecta si está en i-frames.
+// Solo cuenta una vez por dash. Retorna true si se activó.
+func (p *Player) TryPerfectDodge() bool {
+	if !p.IsInvincible() || p.perfectDodgeUsed {
+		return false
+	}
+
+	p.perfectDodgeUsed = true
+	p.DamageBuffTimeLeft = p.config.PerfectDodgeBuffDuration
+
+	p.controller.Vibrate(120, 0.5)
+	return true
+}
+
+// GetPerfectDodgeSlowMotion retorna la duración y escala de la cámara lenta
+func (p *Player) GetPerfectDodgeSlowMotion() (int, float64) {
+	return p.config.PerfectDodgeSlowFrames, p.config.PerfectDodgeSlowScale
+}
+
+// GetDamageMultiplier retorna el multiplicador de daño (buff de esquiva perfecta)
+func (p *Player) GetDamageMultiplier() float64 {
+	if p.DamageBuffTimeLeft > 0 {
+		return 1.0 + p.config.PerfectDodgeDamageBonus
+	}
+	return 1.0
+}
+
+// ============================================================================
+// DAMAGEABLE (pipeline de daño)
+// ============================================================================
+
+// GetActorID identifica al jugador en eventos y en el registro de golpes
+func (p *Player) GetActorID() combat.ActorID {
+	return p.ID
+}
+
+// GetPosition retorna la posición del jugador
+func (p *Player) GetPosition() utils.Vector2 {
+	return p.Position
+}
+
+// CanReceiveHit es false si está muerto o en invulnerabilidad post-golpe
+func (p *Player) CanReceiveHit() bool {
+	return p.State != StateDead && p.InvulnTimeLeft == 0
+}
+
+// ReceiveDamage aplica daño final y knockback, e inicia la invulnerabilidad
+func (p *Player) ReceiveDamage(damage int, knockback utils.Vector2) bool {
+	if !p.CanReceiveHit() || p.IsInvincible() {
+		return false
+	}
+
+	p.TakeDamage(damage, knockback)
+	p.InvulnTimeLeft = p.config.PostHitInvulnFrames
+	return true
+}
+
+// GetDefenses retorna la defensa y resistencias del jugador
+func (p *Player) GetDefenses() combat.Defenses {
+	return combat.Defenses{
+		Defense:     p.config.Defense,
+		Resistances: p.config.Resistances,
+		// Vulnerable aumenta el daño recibido (entra en el desglose)
+		Vulnerability: p.Status.DamageTakenMultiplier() - 1.0,
+	}
+}
+
+// GetStatusEffects retorna los efectos de estado (para efectos al golpear)
+func (p *Player) GetStatusEffects() *combat.StatusEffects {
+	return p.Status
+}
+
+// TryEvade atraviesa el golpe si está en i-frames del dash
+func (p *Player) TryEvade(perfectEligible bool) (evaded, perfect bool) {
+	if !p.IsInvincible() {
+		return false, false
+	}
+
+	if perfectEligible {
+		perfect = p.TryPerfectDodge()
+	}
+	return true, perfect
+}
+
 // TakeDamage recibe daño
 func (p *Player) TakeDamage(damage int, knockback utils.Vector2) {
-	if p.State == StateDead || p.State == StateDashing {
+	if p.State == StateDead || p.IsInvincible() {
 		return
 	}
 
@@ -413,10 +687,19 @@ func (p *Player) Draw(screen *ebiten.Image) {
 		bodyColor = color.RGBA{255, 100, 100, 255}
 	case StateHurt:
 		bodyColor = color.RGBA{255, 0, 0, 255}
+	case StateStunned:
+		bodyColor = color.RGBA{255, 255, 100, 255}
+	case StateGuarding:
+		bodyColor = color.RGBA{180, 180, 255, 255}
 	case StateWallSliding:
 		bodyColor = color.RGBA{100, 200, 255, 255}
 	}
 
+	// Parpadeo durante la invulnerabilidad post-golpe
+	if p.InvulnTimeLeft > 0 && (p.InvulnTimeLeft/4)%2 == 0 {
+		bodyColor.A = 100
+	}
+
 	// Dibujar cuerpo
 	vector.DrawFilledRect(
 		screen,
@@ -440,6 +723,11 @@ func (p *Player) Draw(screen *ebiten.Image) {
 		false,
 	)
 
+	// Escudo de guardia (blanco durante la ventana de parry)
+	if p.State == StateGuarding {
+		p.drawGuardIndicator(screen)
+	}
+
 	// Indicador de dirección (flecha)
 	p.drawDirectionIndicator(screen)
 
@@ -447,6 +735,20 @@ func (p *Player) Draw(screen *ebiten.Image) {
 	if p.CanDash {
 		p.drawDashIndicator(screen)
 	}
+
+	// Aura del buff de esquiva perfecta
+	if p.DamageBuffTimeLeft > 0 {
+		vector.StrokeRect(
+			screen,
+			float32(hitbox.X-3),
+			float32(hitbox.Y-3),
+			float32(hitbox.Width+6),
+			float32(hitbox.Height+6),
+			2,
+			color.RGBA{255, 215, 0, 255},
+			false,
+		)
+	}
 }
 
 // drawDirectionIndicator dibuja una flecha indicando la dirección
@@ -468,6 +770,23 @@ func (p *Player) drawDirectionIndicator(screen *ebiten.Image) {
 	}
 }
 
+// drawGuardIndicator dibuja el escudo delante del jugador
+func (p *Player) drawGuardIndicator(screen *ebiten.Image) {
+	shieldX := float32(p.Position.X + p.Size.X/2 + 4)
+	if !p.FacingRight {
+		shieldX = float32(p.Position.X - p.Size.X/2 - 4)
+	}
+	top := float32(p.Position.Y - p.Size.Y/2)
+	bottom := float32(p.Position.Y + p.Size.Y/2)
+
+	shieldColor := color.RGBA{120, 120, 255, 255}
+	if p.IsInParryWindow() {
+		shieldColor = color.RGBA{255, 255, 255, 255}
+	}
+
+	vector.StrokeLine(screen, shieldX, top, shieldX, bottom, 4, shieldColor, false)
+}
+
 // drawDashIndicator dibuja un indicador de dash disponible
 func (p *Player) drawDashIndicator(screen *ebiten.Image) {
 	x := float32(p.Position.X - 20)
@@ -2,6 +2,7 @@
 package entities
 
 import (
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
 	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
 )
 
@@ -14,8 +15,9 @@ func (p *Player) handleAttackInput() {
 		return
 	}
 
-	// No atacar si no está en estado válido
-	if p.State != StateIdle && p.State != StateWalking && p.State != StateJumping && p.State != StateFalling {
+	// No atacar si no está en estado válido (o en la ventana de cancelación del combo)
+	canCancel := p.State == StateAttacking && p.Attack.CanCancel()
+	if p.State != StateIdle && p.State != StateWalking && p.State != StateJumping && p.State != StateFalling && !canCancel {
 		return
 	}
 
@@ -44,7 +46,7 @@ func (p *Player) performAttack() {
 	}
 
 	p.ComboTimeLeft = p.config.ComboDuration
-	p.AttackTimeLeft = p.config.AttackDuration
+	p.Attack.Start(&p.config.BasicAttack)
 	p.State = StateAttacking
 
 	// Pequeño impulso hacia adelante al atacar
@@ -62,39 +64,19 @@ func (p *Player) performAttack() {
 	p.controller.Vibrate(80, vibrateStrength)
 }
 
-// GetAttackHitbox retorna el hitbox del ataque
+// GetAttackHitbox retorna el hitbox del ataque (solo en frames activos)
 func (p *Player) GetAttackHitbox() *utils.Rectangle {
-	if p.State != StateAttacking {
+	if p.State != StateAttacking || !p.Attack.Is(&p.config.BasicAttack) {
 		return nil
 	}
 
-	attackReach := 40.0
-	attackWidth := 50.0
-	attackHeight := 60.0
-
-	var attackX float64
-	if p.FacingRight {
-		// Atacando a la derecha: empieza desde el borde derecho del jugador
-		attackX = p.Position.X + p.Size.X/2
-	} else {
-		// Atacando a la izquierda: empieza desde attackReach más allá del borde izquierdo
-		attackX = p.Position.X - p.Size.X/2 - attackWidth - attackReach // ← ARREGLADO
-	}
-
-	rect := utils.NewRectangle(
-		attackX,
-		p.Position.Y-attackHeight/2,
-		attackWidth+attackReach, // Ancho total del ataque
-		attackHeight,
-	)
-
-	return &rect
+	return p.Attack.Hitbox(p.Position, p.FacingRight)
 }
 
 // GetAttackDamage retorna el daño del ataque actual
 func (p *Player) GetAttackDamage() int {
-	baseDamage := 10
-	return baseDamage * p.ComboCount
+	baseDamage := p.config.BasicAttack.Damage
+	return int(float64(baseDamage*p.ComboCount) * p.GetDamageMultiplier())
 }
 
 // handleDownAirAttackInput maneja el ataque hacia abajo en el aire
@@ -135,7 +117,7 @@ func (p *Player) performDownAirAttack() {
 	p.Stamina -= 15
 
 	p.State = StateDownAirAttack
-	p.AttackTimeLeft = 20 // Duración del ataque
+	p.Attack.Start(&p.config.DownAirAttack)
 
 	// Impulso hacia abajo (para el pogo effect)
 	p.Velocity.Y = 8 // Caída rápida
@@ -146,27 +128,116 @@ func (p *Player) performDownAirAttack() {
 
 // GetDownAirAttackHitbox retorna el hitbox del ataque hacia abajo
 func (p *Player) GetDownAirAttackHitbox() *utils.Rectangle {
-	if p.State != StateDownAirAttack {
+	if p.State != StateDownAirAttack || !p.Attack.Is(&p.config.DownAirAttack) {
 		return nil
 	}
 
-	// Hitbox debajo del jugador
-	attackWidth := 50.0
-	attackHeight := 40.0
-
-	rect := utils.NewRectangle(
-		p.Position.X-attackWidth/2,
-		p.Position.Y+p.Size.Y/2-10, // Justo debajo
-		attackWidth,
-		attackHeight,
-	)
-
-	return &rect
+	return p.Attack.Hitbox(p.Position, p.FacingRight)
 }
 
 // GetDownAirAttackDamage retorna el daño del ataque hacia abajo
 func (p *Player) GetDownAirAttackDamage() int {
-	return 20 // Más daño que ataque normal
+	return int(float64(p.config.DownAirAttack.Damage) * p.GetDamageMultiplier())
+}
+
+// GetCurrentAttack retorna la definición del ataque en curso (nil si no ataca)
+func (p *Player) GetCurrentAttack() *combat.AttackDefinition {
+	return p.Attack.Def
+}
+
+// ============================================================================
+// GUARDIA Y PARRY
+// ============================================================================
+
+// handleGuardInput levanta la guardia. Retorna true si empezó a guardar.
+func (p *Player) handleGuardInput() bool {
+	if !p.controller.IsGuardPressed() {
+		return false
+	}
+
+	// Solo desde estados neutrales
+	if p.State != StateIdle && p.State != StateWalking && p.State != StateJumping && p.State != StateFalling {
+		return false
+	}
+
+	if p.Stamina <= 0 {
+		p.controller.Vibrate(50, 0.1)
+		return false
+	}
+
+	p.State = StateGuarding
+	p.GuardFrames = 0
+	p.isChargingShot = false
+	p.chargeTime = 0
+
+	return true
+}
+
+// updateGuard mantiene la guardia mientras el botón siga presionado
+func (p *Player) updateGuard() {
+	if p.controller.IsGuardHeld() {
+		// Sin moverse mientras guarda
+		if p.IsOnGround {
+			p.Velocity.X *= 0.5
+		}
+		return
+	}
+
+	p.endGuard()
+}
+
+// endGuard baja la guardia
+func (p *Player) endGuard() {
+	p.State = StateIdle
+	p.GuardFrames = 0
+}
+
+// IsInParryWindow retorna true si un golpe ahora mismo sería un parry
+func (p *Player) IsInParryWindow() bool {
+	return p.State == StateGuarding && p.GuardFrames <= p.config.ParryWindowFrames
+}
+
+// ResolveGuard decide qué pasa con un golpe según de dónde viene.
+// Retorna el resultado y el daño que queda por aplicar.
+func (p *Player) ResolveGuard(hit *combat.Hit, damage int) (combat.GuardResult, int) {
+	if p.State != StateGuarding {
+		return combat.GuardNone, damage
+	}
+
+	// Solo bloquea golpes frontales
+	fromRight := hit.Source.X >= p.Position.X
+	if fromRight != p.FacingRight {
+		return combat.GuardNone, damage
+	}
+
+	// Parry: guardia levantada justo antes del golpe
+	if hit.Parryable && p.IsInParryWindow() {
+		p.Stamina += p.config.ParryStaminaRefund
+		if p.Stamina > p.MaxStamina {
+			p.Stamina = p.MaxStamina
+		}
+
+		// Bajar la guardia para poder castigar
+		p.endGuard()
+		p.controller.Vibrate(150, 0.8)
+		return combat.GuardParried, 0
+	}
+
+	reduced := int(float64(damage) * (1.0 - p.config.GuardDamageReduction))
+	cost := p.config.GuardStaminaCost + float64(damage)*0.5
+
+	// Sin stamina suficiente: guardia rota
+	if p.Stamina < cost {
+		p.Stamina = 0
+		p.endGuard()
+		p.ApplyStun(p.config.GuardBreakStun)
+		return combat.GuardBroken, damage
+	}
+
+	p.Stamina -= cost
+	p.controller.Vibrate(80, 0.4)
+
+	return combat.GuardBlocked, reduced
 }
 
 // handleShootInput maneja el input de disparo (NUEVO - Módulo 7)
@@ -6,8 +6,20 @@ import (
 
 // handleInput procesa el input del jugador
 func (p *Player) handleInput() {
+	// Guardia activa: solo se procesa soltar la guardia
+	if p.State == StateGuarding {
+		p.updateGuard()
+		return
+	}
+
+	// Ventana de cancelación: solo se acepta el siguiente golpe del combo
+	if p.State == StateAttacking && p.Attack.CanCancel() {
+		p.handleAttackInput()
+		return
+	}
+
 	// No procesar input si está en estados bloqueados
-	if p.State == StateDashing || p.State == StateAttacking || p.State == StateHurt || p.State == StateDead {
+	if p.State == StateDashing || p.State == StateAttacking || p.State == StateHurt || p.State == StateStunned || p.State == StateDead {
 		return
 	}
 
@@ -20,6 +32,11 @@ func (p *Player) handleInput() {
 	// Dash
 	p.handleDashInput()
 
+	// Guardia (antes del ataque)
+	if p.handleGuardInput() {
+		return
+	}
+
 	// Ataque (lo implementaremos en player_combat.go)
 	p.handleAttackInput()
 }
@@ -38,7 +55,8 @@ func (p *Player) handleHorizontalInput() {
 			control *= p.config.AirControl
 		}
 
-		targetSpeed := inputX * p.config.MoveSpeed
+		// Slow reduce la velocidad objetivo
+		targetSpeed := inputX * p.config.MoveSpeed * p.Status.SpeedMultiplier()
 		p.Velocity.X += (targetSpeed - p.Velocity.X) * control
 
 		// Limitar velocidad máxima
@@ -139,6 +157,15 @@ func (p *Player) performJump(force float64) {
 	p.controller.Vibrate(30, 0.15)
 }
 
+// PogoBounce rebota al jugador tras conectar el ataque hacia abajo
+// (sobre el boss o un minion) y le devuelve el doble salto
+func (p *Player) PogoBounce() {
+	p.Velocity.Y = -p.config.PogoBounceForce
+	p.State = StateJumping
+	p.Attack.Stop()
+	p.JumpCount = 1
+}
+
 // performWallJump realiza un wall jump ALEJÁNDOSE de la pared
 func (p *Player) performWallJump() {
 	// Saltar en dirección opuesta a la pared
@@ -221,6 +248,7 @@ func (p *Player) performDash() {
 	p.DashCooldown = p.config.DashCooldown
 	p.CanDash = false
 	p.State = StateDashing
+	p.perfectDodgeUsed = false
 
 	// Consumir stamina
 	p.Stamina -= 25
@@ -274,6 +302,9 @@ func (p *Player) applyPhysics() {
 
 // applyMovement aplica el movimiento con colisiones
 func (p *Player) applyMovement() {
+	// El dash es rápido: se recorta al primer contacto antes de mover
+	p.clipDashMovement()
+
 	// =========================================================================
 	// MOVIMIENTO HORIZONTAL (separado del vertical)
 	// =========================================================================
@@ -351,3 +382,26 @@ func (p *Player) applyMovement() {
 		p.Velocity.Y = 0
 	}
 }
+
+// clipDashMovement recorta la velocidad del dash en el eje que choca con la arena.
+// A velocidad de dash, probar solo la posición final podría saltarse una pared fina.
+// El otro eje conserva su velocidad (el dash en diagonal se desliza por la pared).
+func (p *Player) clipDashMovement() {
+	if p.State != StateDashing {
+		return
+	}
+
+	// Dos pasadas: una por eje (en una esquina chocan los dos)
+	for i := 0; i < 2; i++ {
+		hit := p.arena.Sweep(p.GetHitbox(), p.Velocity)
+		if !hit.Hit || hit.Normal == utils.Zero() {
+			return
+		}
+
+		if hit.Normal.X != 0 {
+			p.Velocity.X *= hit.Time
+		} else {
+			p.Velocity.Y *= hit.Time
+		}
+	}
+}
@@ -13,7 +13,9 @@ const (
 	StateDashing
 	StateAttacking
 	StateDownAirAttack
+	StateGuarding
 	StateHurt
+	StateStunned
 	StateDead
 )
 
@@ -38,8 +40,12 @@ func (s PlayerState) String() string {
 		return "Attacking"
 	case StateDownAirAttack:
 		return "DownAirAttack"
+	case StateGuarding:
+		return "Guarding"
 	case StateHurt:
 		return "Hurt"
+	case StateStunned:
+		return "Stunned"
 	case StateDead:
 		return "Dead"
 	default:
@@ -367,6 +367,36 @@ func (c *Controller) IsShootPressed() bool {
 	return false
 }
 
+// IsGuardHeld verifica si el botón de guardia está presionado
+func (c *Controller) IsGuardHeld() bool {
+	// E en teclado
+	if ebiten.IsKeyPressed(ebiten.KeyE) {
+		return true
+	}
+
+	// R1 en gamepad (PlayStation: R1, Xbox: RB)
+	if c.IsGamepadConnected() {
+		if ebiten.IsStandardGamepadButtonPressed(c.gamepadID, ebiten.StandardGamepadButtonFrontTopRight) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsGuardPressed retorna true solo en el frame que se presiona la guardia
+func (c *Controller) IsGuardPressed() bool {
+	if inpututil.IsKeyJustPressed(ebiten.KeyE) {
+		return true
+	}
+
+	if c.IsGamepadConnected() {
+		return inpututil.IsStandardGamepadButtonJustPressed(c.gamepadID, ebiten.StandardGamepadButtonFrontTopRight)
+	}
+
+	return false
+}
+
 // GetRightStickAxis retorna el eje del stick derecho (para apuntar)
 func (c *Controller) GetRightStickAxis() (float64, float64) {
 	if !c.IsGamepadConnected() {
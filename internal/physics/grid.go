@@ -0,0 +1,195 @@
+// internal/physics/grid.go
+package physics
+
+import (
+	"math"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+)
+
+// ============================================================================
+// BROADPHASE: GRILLA UNIFORME
+// ============================================================================
+
+// DefaultCellSize es el lado de celda por defecto: algo más que el hurtbox
+// del jugador, así un objetivo típico cubre pocas celdas
+const DefaultCellSize = 64.0
+
+// Entry es un objeto registrado en la grilla
+type Entry struct {
+	Bounds utils.Rectangle // Área que ocupa (para proyectiles: el recorrido del frame)
+	Owner  combat.ActorID  // Dueño (las consultas filtran por facción)
+	Index  int             // Índice del objeto en la colección de quien lo insertó
+}
+
+// Grid reparte objetos en celdas cuadradas para que cada consulta solo mire
+// los que están cerca. Se reconstruye cada frame (Clear + Insert): insertar es
+// O(1) por celda y, una vez que los buffers crecen, no reserva memoria.
+// No es thread-safe: se usa desde el hilo del juego.
+type Grid struct {
+	bounds   utils.Rectangle // Zona cubierta (lo de afuera cae en las celdas del borde)
+	cellSize float64
+	cols     int
+	rows     int
+
+	cells   [][]int32 // Índices en entries por celda
+	entries []Entry
+
+	// Marca de la última consulta que vio cada entrada: un objeto que ocupa
+	// varias celdas aparece una sola vez en el resultado
+	marks   []uint32
+	queryID uint32
+}
+
+// NewGrid crea una grilla que cubre bounds con celdas de cellSize
+func NewGrid(bounds utils.Rectangle, cellSize float64) *Grid {
+	if cellSize <= 0 {
+		cellSize = DefaultCellSize
+	}
+
+	cols := int(math.Ceil(bounds.Width / cellSize))
+	rows := int(math.Ceil(bounds.Height / cellSize))
+	if cols < 1 {
+		cols = 1
+	}
+	if rows < 1 {
+		rows = 1
+	}
+
+	return &Grid{
+		bounds:   bounds,
+		cellSize: cellSize,
+		cols:     cols,
+		rows:     rows,
+		cells:    make([][]int32, cols*rows),
+	}
+}
+
+// Clear vacía la grilla conservando la memoria de las celdas
+func (g *Grid) Clear() {
+	for i := range g.cells {
+		g.cells[i] = g.cells[i][:0]
+	}
+	g.entries = g.entries[:0]
+	g.marks = g.marks[:0]
+}
+
+// Len retorna cuántos objetos hay en la grilla
+func (g *Grid) Len() int {
+	return len(g.entries)
+}
+
+// Insert registra un objeto en todas las celdas que toca
+func (g *Grid) Insert(bounds utils.Rectangle, owner combat.ActorID, index int) {
+	id := int32(len(g.entries))
+	g.entries = append(g.entries, Entry{Bounds: bounds, Owner: owner, Index: index})
+	g.marks = append(g.marks, 0)
+
+	c0, r0, c1, r1 := g.cellRange(bounds)
+	for row := r0; row <= r1; row++ {
+		for col := c0; col <= c1; col++ {
+			cell := row*g.cols + col
+			g.cells[cell] = append(g.cells[cell], id)
+		}
+	}
+}
+
+// Query agrega a dst los índices de los objetos que tocan area
+// (pasar buf[:0] para reutilizar memoria entre frames)
+func (g *Grid) Query(area utils.Rectangle, dst []int) []int {
+	return g.query(area, queryAll, combat.ActorID{}, dst)
+}
+
+// QueryFaction agrega a dst los objetos de una facción que tocan area
+func (g *Grid) QueryFaction(area utils.Rectangle, faction combat.Faction, dst []int) []int {
+	return g.query(area, queryFaction, combat.ActorID{Faction: faction}, dst)
+}
+
+// QueryHostileTo agrega a dst los objetos que tocan area y pueden dañar a target
+func (g *Grid) QueryHostileTo(area utils.Rectangle, target combat.ActorID, dst []int) []int {
+	return g.query(area, queryHostile, target, dst)
+}
+
+// queryFilter decide qué entradas devuelve una consulta (sin closures: no reserva memoria)
+type queryFilter int
+
+const (
+	queryAll queryFilter = iota
+	queryFaction
+	queryHostile
+)
+
+// query recorre las celdas de area y agrega las entradas que pasan el filtro
+func (g *Grid) query(area utils.Rectangle, filter queryFilter, actor combat.ActorID, dst []int) []int {
+	g.nextQuery()
+
+	c0, r0, c1, r1 := g.cellRange(area)
+	for row := r0; row <= r1; row++ {
+		for col := c0; col <= c1; col++ {
+			for _, id := range g.cells[row*g.cols+col] {
+				if g.marks[id] == g.queryID {
+					continue
+				}
+				g.marks[id] = g.queryID
+
+				entry := &g.entries[id]
+				switch filter {
+				case queryFaction:
+					if entry.Owner.Faction != actor.Faction {
+						continue
+					}
+				case queryHostile:
+					if !combat.CanHarm(entry.Owner, actor) {
+						continue
+					}
+				}
+				if touches(entry.Bounds, area) {
+					dst = append(dst, entry.Index)
+				}
+			}
+		}
+	}
+	return dst
+}
+
+// touches es Intersects incluyendo los bordes: un barrido que termina justo
+// en la cara del objetivo también es contacto para Sweep
+func touches(a, b utils.Rectangle) bool {
+	return a.Left() <= b.Right() &&
+		a.Right() >= b.Left() &&
+		a.Top() <= b.Bottom() &&
+		a.Bottom() >= b.Top()
+}
+
+// nextQuery avanza la marca de consulta (al dar la vuelta limpia las marcas viejas)
+func (g *Grid) nextQuery() {
+	g.queryID++
+	if g.queryID == 0 {
+		for i := range g.marks {
+			g.marks[i] = 0
+		}
+		g.queryID = 1
+	}
+}
+
+// cellRange retorna las celdas (columna, fila) que cubre un rectángulo
+func (g *Grid) cellRange(r utils.Rectangle) (c0, r0, c1, r1 int) {
+	c0 = g.cellCoord(r.Left()-g.bounds.X, g.cols)
+	c1 = g.cellCoord(r.Right()-g.bounds.X, g.cols)
+	r0 = g.cellCoord(r.Top()-g.bounds.Y, g.rows)
+	r1 = g.cellCoord(r.Bottom()-g.bounds.Y, g.rows)
+	return
+}
+
+// cellCoord convierte una distancia al borde en índice de celda (limitado a la grilla)
+func (g *Grid) cellCoord(offset float64, count int) int {
+	cell := int(math.Floor(offset / g.cellSize))
+	if cell < 0 {
+		return 0
+	}
+	if cell >= count {
+		return count - 1
+	}
+	return cell
+}
@@ -0,0 +1,180 @@
+package physics
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
+	"github.com/MarcosBrindis/boss-arena-go/internal/config"
+	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
+)
+
+// bullet es el recorrido de un proyectil en un frame (lo mismo que usa el juego)
+type bullet struct {
+	start utils.Rectangle
+	delta utils.Vector2
+	owner combat.ActorID
+}
+
+// target es un hurtbox que recibe proyectiles
+type target struct {
+	hurtbox utils.Rectangle
+	id      combat.ActorID
+}
+
+// scene es un frame de prueba: proyectiles y objetivos repartidos por la pantalla
+type scene struct {
+	bullets []bullet
+	targets []target
+}
+
+// newScene crea una escena reproducible con n proyectiles y k objetivos
+// (jugador + boss + minions). La mitad de los proyectiles son del jugador.
+func newScene(n, k int, seed int64) *scene {
+	rng := rand.New(rand.NewSource(seed))
+	s := &scene{}
+
+	for i := 0; i < n; i++ {
+		owner := combat.BossActor
+		if i%2 == 0 {
+			owner = combat.PlayerActor
+		}
+		x := rng.Float64() * config.ScreenWidth
+		y := rng.Float64() * config.ScreenHeight
+		s.bullets = append(s.bullets, bullet{
+			start: utils.NewRectangle(x-6, y-6, 12, 12),
+			delta: utils.NewVector2(rng.Float64()*24-12, rng.Float64()*24-12),
+			owner: owner,
+		})
+	}
+
+	for i := 0; i < k; i++ {
+		id := combat.NewActorID(combat.ActorKindMinion, i-2, combat.FactionEnemy)
+		size := utils.NewVector2(40, 40)
+		switch i {
+		case 0:
+			id, size = combat.PlayerActor, utils.NewVector2(30, 50)
+		case 1:
+			id, size = combat.BossActor, utils.NewVector2(100, 120)
+		}
+		x := rng.Float64() * (config.ScreenWidth - size.X)
+		y := rng.Float64() * (config.ScreenHeight - size.Y)
+		s.targets = append(s.targets, target{
+			hurtbox: utils.NewRectangle(x, y, size.X, size.Y),
+			id:      id,
+		})
+	}
+	return s
+}
+
+// newScreenGrid crea una grilla como la del juego (pantalla + margen)
+func newScreenGrid() *Grid {
+	const margin = 50.0
+	bounds := utils.NewRectangle(-margin, -margin, config.ScreenWidth+2*margin, config.ScreenHeight+2*margin)
+	return NewGrid(bounds, DefaultCellSize)
+}
+
+// bruteForce prueba cada proyectil contra cada objetivo y retorna los impactos por objetivo
+func (s *scene) bruteForce(hits [][]int) [][]int {
+	for t, tg := range s.targets {
+		hits[t] = hits[t][:0]
+		for i, b := range s.bullets {
+			if !combat.CanHarm(b.owner, tg.id) {
+				continue
+			}
+			if b.start.Sweep(b.delta, tg.hurtbox).Hit {
+				hits[t] = append(hits[t], i)
+			}
+		}
+	}
+	return hits
+}
+
+// broadphase reconstruye la grilla y solo prueba los candidatos de cada objetivo
+func (s *scene) broadphase(grid *Grid, candidates []int, hits [][]int) ([]int, [][]int) {
+	grid.Clear()
+	for i, b := range s.bullets {
+		grid.Insert(b.start.SweptBounds(b.delta), b.owner, i)
+	}
+
+	for t, tg := range s.targets {
+		hits[t] = hits[t][:0]
+		candidates = grid.QueryHostileTo(tg.hurtbox, tg.id, candidates[:0])
+		slices.Sort(candidates)
+		for _, i := range candidates {
+			b := &s.bullets[i]
+			if b.start.Sweep(b.delta, tg.hurtbox).Hit {
+				hits[t] = append(hits[t], i)
+			}
+		}
+	}
+	return candidates, hits
+}
+
+// La grilla solo descarta pares que no pueden chocar: encuentra los mismos
+// impactos, en el mismo orden, que probar todo contra todo
+func TestGridMatchesBruteForce(t *testing.T) {
+	for _, n := range []int{0, 1, 100, 5000} {
+		for _, k := range []int{1, 6, 64} {
+			s := newScene(n, k, int64(n*100+k))
+			grid := newScreenGrid()
+
+			want := s.bruteForce(make([][]int, k))
+			_, got := s.broadphase(grid, nil, make([][]int, k))
+
+			for i := range s.targets {
+				if !slices.Equal(got[i], want[i]) {
+					t.Fatalf("n=%d k=%d objetivo %d: grilla %v, fuerza bruta %v", n, k, i, got[i], want[i])
+				}
+			}
+		}
+	}
+}
+
+// Un objeto fuera de la zona cubierta cae en las celdas del borde y se sigue encontrando
+func TestGridClampsOutOfBounds(t *testing.T) {
+	grid := NewGrid(utils.NewRectangle(0, 0, 256, 256), 64)
+	far := utils.NewRectangle(-500, 900, 10, 10)
+	grid.Insert(far, combat.BossActor, 7)
+
+	got := grid.QueryHostileTo(far, combat.PlayerActor, nil)
+	if !slices.Equal(got, []int{7}) {
+		t.Fatalf("QueryHostileTo = %v, se esperaba [7]", got)
+	}
+	if got := grid.QueryFaction(far, combat.FactionPlayer, nil); len(got) != 0 {
+		t.Fatalf("QueryFaction(jugador) = %v, se esperaba vacío", got)
+	}
+}
+
+// BenchmarkGrid compara fuerza bruta con la grilla al crecer la cantidad de
+// proyectiles, con pocos objetivos (1v1) y con muchos (invocaciones):
+//
+//	go test -bench Grid -benchmem ./internal/physics
+func BenchmarkGrid(b *testing.B) {
+	for _, k := range []int{6, 64} {
+		for _, n := range []int{100, 1000, 5000, 10000} {
+			s := newScene(n, k, 42)
+			hits := make([][]int, k)
+
+			b.Run(fmt.Sprintf("targets=%d/bullets=%d/brute", k, n), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					hits = s.bruteForce(hits)
+				}
+			})
+
+			b.Run(fmt.Sprintf("targets=%d/bullets=%d/grid", k, n), func(b *testing.B) {
+				grid := newScreenGrid()
+				var candidates []int
+				candidates, hits = s.broadphase(grid, candidates, hits) // Buffers ya crecidos
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					candidates, hits = s.broadphase(grid, candidates, hits)
+				}
+			})
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
 	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -25,10 +26,21 @@ type ProjectileManager struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
+	// Proyectiles que terminaron desde el último DrainFinished
+	finished []FinishedProjectile
+
 	// Estadísticas
 	activeCount int
 }
 
+// FinishedProjectile describe un proyectil que terminó (impacto, tiempo o límites)
+type FinishedProjectile struct {
+	AttackID uint64
+	Type     ProjectileType
+	Owner    combat.ActorID
+	Position utils.Vector2
+}
+
 // NewProjectileManager crea un nuevo manager de proyectiles
 func NewProjectileManager(poolSize int, useWorkerPool bool) *ProjectileManager {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -54,7 +66,7 @@ func NewProjectileManager(poolSize int, useWorkerPool bool) *ProjectileManager {
 func (pm *ProjectileManager) Spawn(
 	projectileType ProjectileType,
 	position, direction utils.Vector2,
-	owner string,
+	owner combat.ActorID,
 ) *Projectile {
 	// Obtener del pool
 	projectile := pm.pool.Get(projectileType, position, direction, owner)
@@ -115,12 +127,21 @@ func (pm *ProjectileManager) Update() {
 		}
 	}
 
+	// Dividir ondas de choque (onda doble)
+	pm.spawnSplits()
+
 	// Limpiar proyectiles inactivos y devolverlos al pool
 	activeProj := pm.projectiles[:0]
 	for _, p := range pm.projectiles {
 		if p.IsActive {
 			activeProj = append(activeProj, p)
 		} else {
+			pm.finished = append(pm.finished, FinishedProjectile{
+				AttackID: p.AttackID,
+				Type:     p.Type,
+				Owner:    p.Owner,
+				Position: p.Position,
+			})
 			pm.pool.Put(p)
 		}
 	}
@@ -130,6 +151,30 @@ func (pm *ProjectileManager) Update() {
 	pm.mu.Unlock()
 }
 
+// spawnSplits crea la segunda onda de los proyectiles que pidieron dividirse
+// (llamar con pm.mu bloqueado)
+func (pm *ProjectileManager) spawnSplits() {
+	count := len(pm.projectiles)
+	for i := 0; i < count; i++ {
+		p := pm.projectiles[i]
+		if !p.IsActive || !p.WantsSplit {
+			continue
+		}
+		p.WantsSplit = false
+
+		// La segunda onda sale del mismo punto, más lenta, para que haya que saltar dos veces
+		direction := p.Velocity.Normalize()
+		child := pm.pool.Get(p.Type, p.Position, direction, p.Owner)
+		child.Speed = p.Speed * 0.6
+		child.Velocity = direction.Mul(child.Speed)
+		child.Damage = p.Damage
+		child.DamageType = p.DamageType
+		child.Lifetime = p.Lifetime - p.Age
+
+		pm.projectiles = append(pm.projectiles, child)
+	}
+}
+
 // Draw dibuja todos los proyectiles
 func (pm *ProjectileManager) Draw(screen *ebiten.Image) {
 	pm.mu.Lock()
@@ -142,32 +187,56 @@ func (pm *ProjectileManager) Draw(screen *ebiten.Image) {
 	}
 }
 
-// GetActiveProjectiles retorna una copia de los proyectiles activos
-func (pm *ProjectileManager) GetActiveProjectiles() []*Projectile {
+// GetActiveProjectiles agrega a dst los proyectiles activos y lo retorna
+// (pasar buf[:0] para reutilizar memoria entre frames; nil reserva uno nuevo)
+func (pm *ProjectileManager) GetActiveProjectiles(dst []*Projectile) []*Projectile {
 	pm.mu.Lock()
 	defer pm.mu.Unlock()
 
-	// Retornar copia para evitar data races
-	projectiles := make([]*Projectile, len(pm.projectiles))
-	copy(projectiles, pm.projectiles)
-	return projectiles
+	// Copia para evitar data races
+	return append(dst, pm.projectiles...)
 }
 
-// GetProjectilesByOwner retorna proyectiles de un propietario específico
-func (pm *ProjectileManager) GetProjectilesByOwner(owner string) []*Projectile {
+// GetProjectilesByOwner agrega a dst los proyectiles de un actor específico
+func (pm *ProjectileManager) GetProjectilesByOwner(owner combat.ActorID, dst []*Projectile) []*Projectile {
 	pm.mu.Lock()
 	defer pm.mu.Unlock()
 
-	result := make([]*Projectile, 0)
 	for _, p := range pm.projectiles {
 		if p.IsActive && p.Owner == owner {
-			result = append(result, p)
+			dst = append(dst, p)
+		}
+	}
+	return dst
+}
+
+// GetProjectilesHostileTo agrega a dst los proyectiles que pueden dañar al actor
+func (pm *ProjectileManager) GetProjectilesHostileTo(target combat.ActorID, dst []*Projectile) []*Projectile {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	for _, p := range pm.projectiles {
+		if p.IsActive && combat.CanHarm(p.Owner, target) {
+			dst = append(dst, p)
 		}
 	}
-	return result
+	return dst
+}
+
+// DrainFinished retorna los proyectiles que terminaron desde la última llamada y vacía la lista
+func (pm *ProjectileManager) DrainFinished() []FinishedProjectile {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if len(pm.finished) == 0 {
+		return nil
+	}
+	finished := pm.finished
+	pm.finished = nil
+	return finished
 }
 
-// Clear limpia todos los proyectiles
+// Clear limpia todos los proyectiles (sin reportarlos como terminados)
 func (pm *ProjectileManager) Clear() {
 	pm.mu.Lock()
 	defer pm.mu.Unlock()
@@ -178,6 +247,7 @@ func (pm *ProjectileManager) Clear() {
 	}
 
 	pm.projectiles = pm.projectiles[:0]
+	pm.finished = nil
 	pm.activeCount = 0
 }
 
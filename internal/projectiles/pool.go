@@ -3,6 +3,7 @@ package projectiles
 import (
 	"sync"
 
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
 	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
 )
 
@@ -33,7 +34,7 @@ func NewProjectilePool(size int) *ProjectilePool {
 func (pp *ProjectilePool) Get(
 	projectileType ProjectileType,
 	position, direction utils.Vector2,
-	owner string,
+	owner combat.ActorID,
 ) *Projectile {
 	var projectile *Projectile
 
@@ -61,14 +62,21 @@ func (pp *ProjectilePool) Get(
 
 	// Reconfigurar el proyectil
 	projectile.Type = projectileType
+	projectile.AttackID = combat.NewAttackID()
 	projectile.Position = position
+	projectile.PrevPosition = position
 	projectile.Owner = owner
 	projectile.Age = 0
 	projectile.IsActive = true
+	projectile.IsGrounded = false
+	projectile.SplitAfter = 0
+	projectile.WantsSplit = false
+	projectile.PoiseDamage = 0
 
 	// Aplicar configuración según tipo
 	switch projectileType {
 	case ProjectilePlayerBasic:
+		projectile.DamageType = combat.DamagePhysical
 		projectile.Speed = 12.0
 		projectile.Damage = 15
 		projectile.Lifetime = 180
@@ -77,6 +85,8 @@ func (pp *ProjectilePool) Get(
 		projectile.IsHoming = false
 
 	case ProjectilePlayerCharged:
+		projectile.DamageType = combat.DamageMagic
+		projectile.PoiseDamage = 2.5
 		projectile.Speed = 10.0
 		projectile.Damage = 30
 		projectile.Lifetime = 240
@@ -85,6 +95,7 @@ func (pp *ProjectilePool) Get(
 		projectile.IsHoming = false
 
 	case ProjectileBossFireball:
+		projectile.DamageType = combat.DamageFire
 		projectile.Speed = 8.0
 		projectile.Damage = 20
 		projectile.Lifetime = 300
@@ -93,6 +104,7 @@ func (pp *ProjectilePool) Get(
 		projectile.IsHoming = false
 
 	case ProjectileBossMissile:
+		projectile.DamageType = combat.DamagePhysical
 		projectile.Speed = 6.0
 		projectile.Damage = 25
 		projectile.Lifetime = 360
@@ -100,6 +112,16 @@ func (pp *ProjectilePool) Get(
 		projectile.Color.R, projectile.Color.G, projectile.Color.B = 255, 0, 255
 		projectile.IsHoming = true
 		projectile.HomingForce = 0.3
+
+	case ProjectileBossShockwave:
+		projectile.DamageType = combat.DamagePhysical
+		projectile.Speed = 5.0
+		projectile.Damage = 20
+		projectile.Lifetime = 240
+		projectile.Size = utils.NewVector2(36, 24)
+		projectile.Color.R, projectile.Color.G, projectile.Color.B = 255, 180, 60
+		projectile.IsHoming = false
+		projectile.IsGrounded = true
 	}
 
 	projectile.Color.A = 255
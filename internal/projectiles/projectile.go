@@ -3,6 +3,7 @@ package projectiles
 import (
 	"image/color"
 
+	"github.com/MarcosBrindis/boss-arena-go/internal/combat"
 	"github.com/MarcosBrindis/boss-arena-go/internal/utils"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
@@ -16,28 +17,51 @@ const (
 	ProjectilePlayerCharged                       // Proyectil cargado (más daño)
 	ProjectileBossFireball                        // Bola de fuego del boss
 	ProjectileBossMissile                         // Misil del boss (persigue)
+	ProjectileBossShockwave                       // Onda de choque del slam (viaja por el suelo)
 )
 
+// String retorna el nombre del tipo (para metadata de eventos)
+func (pt ProjectileType) String() string {
+	switch pt {
+	case ProjectilePlayerBasic:
+		return "player_shot"
+	case ProjectilePlayerCharged:
+		return "player_charged_shot"
+	case ProjectileBossFireball:
+		return "boss_fireball"
+	case ProjectileBossMissile:
+		return "boss_missile"
+	case ProjectileBossShockwave:
+		return "boss_shockwave"
+	default:
+		return "unknown"
+	}
+}
+
 // Projectile representa un proyectil en el juego
 type Projectile struct {
 	// Identificación
-	ID   int
-	Type ProjectileType
+	ID       int
+	Type     ProjectileType
+	AttackID uint64 // Instancia de ataque (se renueva al reutilizarlo o reflejarlo)
 
 	// Física
-	Position utils.Vector2
-	Velocity utils.Vector2
-	Size     utils.Vector2
+	Position     utils.Vector2
+	PrevPosition utils.Vector2 // Posición al empezar el frame (colisión continua)
+	Velocity     utils.Vector2
+	Size         utils.Vector2
 
 	// Propiedades
-	Damage   int
-	Speed    float64
-	Lifetime int // Frames antes de auto-destruirse
-	Age      int // Frames transcurridos
-	IsActive bool
+	Damage      int
+	DamageType  combat.DamageType
+	PoiseDamage float64 // Desgaste de postura por punto de daño (0 = 1x)
+	Speed       float64
+	Lifetime    int // Frames antes de auto-destruirse
+	Age         int // Frames transcurridos
+	IsActive    bool
 
 	// Propietario
-	Owner string // "player" o "boss"
+	Owner combat.ActorID
 
 	// Visual
 	Color color.RGBA
@@ -46,22 +70,30 @@ type Projectile struct {
 	IsHoming    bool           // Si persigue al objetivo
 	Target      *utils.Vector2 // Objetivo para proyectiles homing
 	HomingForce float64        // Fuerza de persecución
+
+	// Ondas de choque (pegadas al suelo)
+	IsGrounded bool // Mantiene su altura (no se mueve en Y)
+	SplitAfter int  // Frames tras los que se divide en una onda doble (0 = nunca)
+	WantsSplit bool // Señal para que el manager cree la segunda onda
 }
 
 // NewProjectile crea un nuevo proyectil (factory function)
-func NewProjectile(id int, projectileType ProjectileType, position, direction utils.Vector2, owner string) *Projectile {
+func NewProjectile(id int, projectileType ProjectileType, position, direction utils.Vector2, owner combat.ActorID) *Projectile {
 	p := &Projectile{
-		ID:       id,
-		Type:     projectileType,
-		Position: position,
-		Owner:    owner,
-		Age:      0,
-		IsActive: true,
+		ID:           id,
+		Type:         projectileType,
+		AttackID:     combat.NewAttackID(),
+		Position:     position,
+		PrevPosition: position,
+		Owner:        owner,
+		Age:          0,
+		IsActive:     true,
 	}
 
 	// Configurar según tipo
 	switch projectileType {
 	case ProjectilePlayerBasic:
+		p.DamageType = combat.DamagePhysical
 		p.Speed = 12.0
 		p.Damage = 15
 		p.Lifetime = 180 // 3 segundos @ 60 FPS
@@ -70,6 +102,8 @@ func NewProjectile(id int, projectileType ProjectileType, position, direction ut
 		p.IsHoming = false
 
 	case ProjectilePlayerCharged:
+		p.DamageType = combat.DamageMagic
+		p.PoiseDamage = 2.5
 		p.Speed = 10.0
 		p.Damage = 30
 		p.Lifetime = 240
@@ -78,6 +112,7 @@ func NewProjectile(id int, projectileType ProjectileType, position, direction ut
 		p.IsHoming = false
 
 	case ProjectileBossFireball:
+		p.DamageType = combat.DamageFire
 		p.Speed = 8.0
 		p.Damage = 20
 		p.Lifetime = 300
@@ -86,6 +121,7 @@ func NewProjectile(id int, projectileType ProjectileType, position, direction ut
 		p.IsHoming = false
 
 	case ProjectileBossMissile:
+		p.DamageType = combat.DamagePhysical
 		p.Speed = 6.0
 		p.Damage = 25
 		p.Lifetime = 360
@@ -93,6 +129,16 @@ func NewProjectile(id int, projectileType ProjectileType, position, direction ut
 		p.Color = color.RGBA{255, 0, 255, 255} // Magenta
 		p.IsHoming = true
 		p.HomingForce = 0.3
+
+	case ProjectileBossShockwave:
+		p.DamageType = combat.DamagePhysical
+		p.Speed = 5.0
+		p.Damage = 20
+		p.Lifetime = 240
+		p.Size = utils.NewVector2(36, 24)
+		p.Color = color.RGBA{255, 180, 60, 255} // Naranja claro
+		p.IsHoming = false
+		p.IsGrounded = true
 	}
 
 	// Calcular velocidad inicial
@@ -121,7 +167,18 @@ func (p *Projectile) Update() {
 		p.updateHoming()
 	}
 
+	// Las ondas de choque viajan pegadas al suelo
+	if p.IsGrounded {
+		p.Velocity.Y = 0
+	}
+
+	// División en onda doble
+	if p.SplitAfter > 0 && p.Age == p.SplitAfter {
+		p.WantsSplit = true
+	}
+
 	// Aplicar movimiento
+	p.PrevPosition = p.Position
 	p.Position = p.Position.Add(p.Velocity)
 
 	// Verificar límites de pantalla
@@ -168,12 +225,34 @@ func (p *Projectile) GetHitbox() utils.Rectangle {
 	)
 }
 
+// GetSweep retorna el hitbox al empezar el frame y cuánto se movió (colisión continua)
+func (p *Projectile) GetSweep() (utils.Rectangle, utils.Vector2) {
+	start := utils.NewRectangle(
+		p.PrevPosition.X-p.Size.X/2,
+		p.PrevPosition.Y-p.Size.Y/2,
+		p.Size.X,
+		p.Size.Y,
+	)
+	return start, p.Position.Sub(p.PrevPosition)
+}
+
+// ImpactPoint retorna dónde estaba el proyectil en la fracción t del movimiento del frame
+func (p *Projectile) ImpactPoint(t float64) utils.Vector2 {
+	return p.PrevPosition.Add(p.Position.Sub(p.PrevPosition).Mul(t))
+}
+
 // Draw dibuja el proyectil
 func (p *Projectile) Draw(screen *ebiten.Image) {
 	if !p.IsActive {
 		return
 	}
 
+	// Las ondas de choque se dibujan como una cresta sobre el suelo
+	if p.Type == ProjectileBossShockwave {
+		p.drawShockwave(screen)
+		return
+	}
+
 	// Dibujar círculo
 	vector.DrawFilledCircle(
 		screen,
@@ -210,11 +289,59 @@ func (p *Projectile) Draw(screen *ebiten.Image) {
 	}
 }
 
+// drawShockwave dibuja una onda de choque (base + cresta en la dirección de avance)
+func (p *Projectile) drawShockwave(screen *ebiten.Image) {
+	hitbox := p.GetHitbox()
+
+	// Base de la onda
+	vector.DrawFilledRect(
+		screen,
+		float32(hitbox.X),
+		float32(hitbox.Y+hitbox.Height/2),
+		float32(hitbox.Width),
+		float32(hitbox.Height/2),
+		p.Color,
+		false,
+	)
+
+	// Cresta inclinada hacia donde avanza
+	crestX := hitbox.Left()
+	if p.Velocity.X > 0 {
+		crestX = hitbox.Right()
+	}
+	vector.StrokeLine(
+		screen,
+		float32(hitbox.Center().X),
+		float32(hitbox.Bottom()),
+		float32(crestX),
+		float32(hitbox.Top()),
+		4,
+		p.Color,
+		false,
+	)
+
+	// Brillo en la base
+	vector.StrokeLine(
+		screen,
+		float32(hitbox.Left()),
+		float32(hitbox.Bottom()),
+		float32(hitbox.Right()),
+		float32(hitbox.Bottom()),
+		2,
+		color.White,
+		false,
+	)
+}
+
 // Reset resetea el proyectil para reutilización (pooling)
 func (p *Projectile) Reset() {
 	p.Position = utils.Zero()
+	p.PrevPosition = utils.Zero()
 	p.Velocity = utils.Zero()
 	p.Age = 0
 	p.IsActive = false
 	p.Target = nil
+	p.IsGrounded = false
+	p.SplitAfter = 0
+	p.WantsSplit = false
 }
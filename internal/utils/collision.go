@@ -1,5 +1,7 @@
 package utils
 
+import "math"
+
 // Rectangle representa un rectángulo AABB (Axis-Aligned Bounding Box)
 type Rectangle struct {
 	X      float64 // Posición X (esquina superior izquierda)
@@ -130,3 +132,80 @@ func (r Rectangle) Scale(factor float64) Rectangle {
 		Height: newHeight,
 	}
 }
+
+// ============================================================================
+// COLISIÓN CONTINUA (SWEPT AABB)
+// ============================================================================
+
+// SweepResult es el primer contacto de un rectángulo en movimiento
+type SweepResult struct {
+	Hit    bool
+	Time   float64 // Fracción del movimiento hasta el contacto (0 = ya se tocaban, 1 = al final)
+	Normal Vector2 // Cara golpeada (cero si ya se solapaban al empezar)
+}
+
+// noSweepHit es el resultado sin contacto (recorre todo el movimiento)
+var noSweepHit = SweepResult{Time: 1}
+
+// Sweep mueve r por delta y retorna el primer contacto con other.
+// A diferencia de Intersects en la posición final, no deja que un objeto
+// rápido atraviese a uno delgado entre dos frames.
+func (r Rectangle) Sweep(delta Vector2, other Rectangle) SweepResult {
+	if r.Intersects(other) {
+		return SweepResult{Hit: true, Time: 0}
+	}
+
+	entryX, exitX := sweepAxis(r.Left(), r.Right(), other.Left(), other.Right(), delta.X)
+	entryY, exitY := sweepAxis(r.Top(), r.Bottom(), other.Top(), other.Bottom(), delta.Y)
+
+	entry := Max(entryX, entryY)
+	exit := Min(exitX, exitY)
+	if entry >= exit || entry < 0 || entry > 1 {
+		return noSweepHit
+	}
+
+	// La cara golpeada es la del último eje en entrar
+	result := SweepResult{Hit: true, Time: entry}
+	if entryX > entryY {
+		result.Normal = Vector2{X: -Sign(delta.X)}
+	} else {
+		result.Normal = Vector2{Y: -Sign(delta.Y)}
+	}
+	return result
+}
+
+// sweepAxis retorna en qué fracción del movimiento se entra y se sale del solapamiento en un eje
+func sweepAxis(minA, maxA, minB, maxB, delta float64) (entry, exit float64) {
+	switch {
+	case delta > 0:
+		return (minB - maxA) / delta, (maxB - minA) / delta
+	case delta < 0:
+		return (maxB - minA) / delta, (minB - maxA) / delta
+	}
+
+	// Sin movimiento en este eje: se solapan siempre o nunca
+	if maxA > minB && minA < maxB {
+		return math.Inf(-1), math.Inf(1)
+	}
+	return math.Inf(1), math.Inf(-1)
+}
+
+// SweptBounds retorna el rectángulo que cubre todo el recorrido (broadphase)
+func (r Rectangle) SweptBounds(delta Vector2) Rectangle {
+	x := Min(r.X, r.X+delta.X)
+	y := Min(r.Y, r.Y+delta.Y)
+	return Rectangle{
+		X:      x,
+		Y:      y,
+		Width:  r.Width + Abs(delta.X),
+		Height: r.Height + Abs(delta.Y),
+	}
+}
+
+// Earliest retorna el contacto que ocurre antes de los dos
+func (s SweepResult) Earliest(other SweepResult) SweepResult {
+	if !other.Hit || (s.Hit && s.Time <= other.Time) {
+		return s
+	}
+	return other
+}
@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRectangleSweep(t *testing.T) {
+	box := NewRectangle(0, 0, 10, 10)
+
+	tests := []struct {
+		name   string
+		other  Rectangle
+		delta  Vector2
+		hit    bool
+		time   float64
+		normal Vector2
+	}{
+		{
+			name:  "ya solapados al empezar",
+			other: NewRectangle(5, 5, 10, 10),
+			delta: Vector2{X: 20, Y: -3},
+			hit:   true,
+			time:  0,
+		},
+		{
+			name:   "solo eje X (delta Y cero)",
+			other:  NewRectangle(15, 0, 10, 10),
+			delta:  Vector2{X: 10},
+			hit:    true,
+			time:   0.5,
+			normal: Vector2{X: -1},
+		},
+		{
+			name:   "solo eje Y (delta X cero), hacia arriba",
+			other:  NewRectangle(0, -30, 10, 10),
+			delta:  Vector2{Y: -40},
+			hit:    true,
+			time:   0.5,
+			normal: Vector2{Y: 1},
+		},
+		{
+			name:  "delta Y cero sin solape vertical",
+			other: NewRectangle(15, 20, 10, 10),
+			delta: Vector2{X: 50},
+		},
+		{
+			name:  "delta Y cero rozando el borde no cuenta",
+			other: NewRectangle(15, 10, 10, 10),
+			delta: Vector2{X: 50},
+		},
+		{
+			name:   "contacto justo en t=1",
+			other:  NewRectangle(20, 0, 10, 10),
+			delta:  Vector2{X: 10},
+			hit:    true,
+			time:   1,
+			normal: Vector2{X: -1},
+		},
+		{
+			name:  "se queda corto",
+			other: NewRectangle(20, 0, 10, 10),
+			delta: Vector2{X: 9.9},
+		},
+		{
+			name:   "cara con cara al empezar",
+			other:  NewRectangle(10, 0, 10, 10),
+			delta:  Vector2{X: 5},
+			hit:    true,
+			time:   0,
+			normal: Vector2{X: -1},
+		},
+		{
+			name:   "atraviesa un objetivo delgado",
+			other:  NewRectangle(50, 0, 2, 10),
+			delta:  Vector2{X: 100},
+			hit:    true,
+			time:   0.4,
+			normal: Vector2{X: -1},
+		},
+		{
+			name:  "se aleja",
+			other: NewRectangle(20, 0, 10, 10),
+			delta: Vector2{X: -10},
+		},
+		{
+			name:   "diagonal: la cara es la del último eje en entrar",
+			other:  NewRectangle(20, 30, 10, 10),
+			delta:  Vector2{X: 20, Y: 40},
+			hit:    true,
+			time:   0.5,
+			normal: Vector2{X: 0, Y: -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := box.Sweep(tt.delta, tt.other)
+			if got.Hit != tt.hit {
+				t.Fatalf("Hit = %v, se esperaba %v (%+v)", got.Hit, tt.hit, got)
+			}
+			if !tt.hit {
+				if got != noSweepHit {
+					t.Errorf("sin contacto debería retornar %+v, retornó %+v", noSweepHit, got)
+				}
+				return
+			}
+			if math.Abs(got.Time-tt.time) > 1e-9 {
+				t.Errorf("Time = %v, se esperaba %v", got.Time, tt.time)
+			}
+			if got.Normal != tt.normal {
+				t.Errorf("Normal = %+v, se esperaba %+v", got.Normal, tt.normal)
+			}
+		})
+	}
+}
+
+func TestSweepAxis(t *testing.T) {
+	inf := math.Inf(1)
+
+	tests := []struct {
+		name                   string
+		minA, maxA, minB, maxB float64
+		delta                  float64
+		entry, exit            float64
+	}{
+		{"avanza hacia B", 0, 10, 20, 30, 10, 1, 3},
+		{"retrocede hacia B", 20, 30, 0, 10, -10, 1, 3},
+		{"avanza alejándose de B", 20, 30, 0, 10, 10, -3, -1},
+		{"quieto y solapado", 0, 10, 5, 15, 0, -inf, inf},
+		{"quieto y separado", 0, 10, 20, 30, 0, inf, -inf},
+		{"quieto rozando el borde", 0, 10, 10, 20, 0, inf, -inf},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, exit := sweepAxis(tt.minA, tt.maxA, tt.minB, tt.maxB, tt.delta)
+			if entry != tt.entry || exit != tt.exit {
+				t.Errorf("sweepAxis = (%v, %v), se esperaba (%v, %v)", entry, exit, tt.entry, tt.exit)
+			}
+		})
+	}
+}
@@ -178,6 +178,21 @@ func (a *Arena) CheckCollision(rect utils.Rectangle) (bool, utils.Vector2) {
 	return false, utils.Zero()
 }
 
+// Sweep retorna el primer contacto de un rectángulo que se mueve delta (colisión continua)
+func (a *Arena) Sweep(rect utils.Rectangle, delta utils.Vector2) utils.SweepResult {
+	result := rect.Sweep(delta, a.floor.Rect)
+
+	for _, wall := range a.wallsLeft {
+		result = result.Earliest(rect.Sweep(delta, wall.Rect))
+	}
+
+	for _, wall := range a.wallsRight {
+		result = result.Earliest(rect.Sweep(delta, wall.Rect))
+	}
+
+	return result
+}
+
 func (a *Arena) IsOnGround(rect utils.Rectangle) bool {
 	testRect := utils.NewRectangle(
 		rect.X,